@@ -6,6 +6,10 @@ import (
 	"errors"
 	"flag"
 	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -14,20 +18,49 @@ import (
 
 	"github.com/mpyw/goroutinectx/internal"
 	"github.com/mpyw/goroutinectx/internal/checkers"
+	"github.com/mpyw/goroutinectx/internal/checkers/ctxinconstructor"
+	"github.com/mpyw/goroutinectx/internal/checkers/ctxindto"
+	"github.com/mpyw/goroutinectx/internal/checkers/ctxmapkey"
+	"github.com/mpyw/goroutinectx/internal/checkers/ctxparamfield"
+	"github.com/mpyw/goroutinectx/internal/checkers/ctxvalueassert"
+	"github.com/mpyw/goroutinectx/internal/checkers/escapinggoroutine"
+	"github.com/mpyw/goroutinectx/internal/checkers/initgoroutine"
+	"github.com/mpyw/goroutinectx/internal/checkers/lockedgoroutine"
+	"github.com/mpyw/goroutinectx/internal/checkers/requestctx"
 	"github.com/mpyw/goroutinectx/internal/checkers/spawnerlabel"
+	"github.com/mpyw/goroutinectx/internal/configcheck"
 	"github.com/mpyw/goroutinectx/internal/deriver"
 	"github.com/mpyw/goroutinectx/internal/directive/carrier"
+	"github.com/mpyw/goroutinectx/internal/directive/checkarg"
+	"github.com/mpyw/goroutinectx/internal/directive/dirconfig"
 	"github.com/mpyw/goroutinectx/internal/directive/ignore"
 	"github.com/mpyw/goroutinectx/internal/directive/spawner"
+	"github.com/mpyw/goroutinectx/internal/directive/strict"
+	"github.com/mpyw/goroutinectx/internal/libspec"
 	"github.com/mpyw/goroutinectx/internal/registry"
 	"github.com/mpyw/goroutinectx/internal/ssa"
 )
 
 // Flags for the analyzer.
 var (
-	goroutineDeriver string
-	externalSpawner  string
-	contextCarriers  string
+	goroutineDeriver   string
+	deriverPackages    string
+	externalSpawner    string
+	contextCarriers    string
+	ctxRequiredFuncs   string
+	asyncLaunchers     string
+	messageStyle       string
+	minConfidence      string
+	fireAndForgetFuncs string
+	libSpecsFile       string
+
+	explainMissingDeriver bool
+	trackStructCtxFields  bool
+	recognizeAccessors    bool
+	verboseMessages       bool
+	gotaskDeriverFirst    bool
+	exportedOnly          bool
+	trackLocalContexts    bool
 
 	// Checker enable/disable flags (all enabled by default).
 	enableGoroutine    bool
@@ -37,15 +70,87 @@ var (
 	enableSpawner      bool
 	enableSpawnerlabel bool
 	enableGotask       bool
+
+	// Opt-in rules (disabled by default).
+	enableSignal            bool
+	enableNilCtx            bool
+	enableGroupCtx          bool
+	enableTimeTick          bool
+	enableWithoutCancel     bool
+	enableReturnCtxErr      bool
+	enableBlockingIO        bool
+	enableCtxInSlice        bool
+	enableCtxChanSend       bool
+	enableUseAfterCancel    bool
+	enableExecCommand       bool
+	enableSlogStructCtx     bool
+	enableRedundantDerive   bool
+	enableWaitError         bool
+	enableRequestCtx        bool
+	enableStaleCtx          bool
+	enablePrintCtx          bool
+	enableIgnoredCtxErr     bool
+	enableLoopBackground    bool
+	enableCtxInDTO          bool
+	enableCtxValueAssert    bool
+	enableHandlerMap        bool
+	enableCtxParamField     bool
+	enableCtxInCache        bool
+	enableInitGoroutine     bool
+	enableEscapingGoroutine bool
+	enableBackoff           bool
+	enableSyncOnce          bool
+	enableCtxMapKey         bool
+	enableCtxInConstructor  bool
+	enableStdLog            bool
+	enableUnguardedChanOp   bool
+	enableCtxFreeVarStore   bool
+	enableTransitive        bool
+	enableLockedGoroutine   bool
+	blockingFuncs           string
+	cacheSetFuncs           string
+	closureStoreFuncs       string
+	preferredLogger         string
+	allowNilCtxGuard        bool
 )
 
 func init() {
 	Analyzer.Flags.StringVar(&goroutineDeriver, "goroutine-deriver", "",
 		"require goroutines to call this function to derive context (e.g., pkg.Func or pkg.Type.Method)")
+	Analyzer.Flags.StringVar(&deriverPackages, "deriver-packages", "",
+		"comma-separated list of packages whose exported functions returning context.Context are all valid derivers")
 	Analyzer.Flags.StringVar(&externalSpawner, "external-spawner", "",
 		"comma-separated list of external spawner functions (e.g., pkg.Func or pkg.Type.Method)")
 	Analyzer.Flags.StringVar(&contextCarriers, "context-carriers", "",
 		"comma-separated list of types to treat as context carriers (e.g., github.com/labstack/echo/v4.Context)")
+	Analyzer.Flags.StringVar(&ctxRequiredFuncs, "ctx-required-funcs", "",
+		"comma-separated list of functions whose argument must be an in-scope context (e.g., pkg.Func:0 or pkg.Type.Method:1)")
+	Analyzer.Flags.StringVar(&closureStoreFuncs, "closure-store-funcs", "",
+		"comma-separated list of functions storing their func arguments long-term (e.g., pkg.Func or pkg.Type.Method), reporting closures that capture the context in scope")
+	Analyzer.Flags.StringVar(&asyncLaunchers, "async-launchers", "",
+		"with -goroutine-deriver, comma-separated list of DoAsync-style methods whose context must call the deriver, given by argument index or by builder method (e.g., pkg.Type.Run:1 or pkg.Type.Start:WithContext)")
+	Analyzer.Flags.StringVar(&messageStyle, "message-style", string(checkers.MessageStyleLegacy),
+		"wording of go statement diagnostics: legacy or unified (\"go statement closure should use context ...\")")
+	Analyzer.Flags.BoolVar(&verboseMessages, "verbose-messages", false,
+		"name every context in scope, not only the first, in diagnostics of closures that should use one")
+	Analyzer.Flags.StringVar(&minConfidence, "min-confidence", internal.ConfidenceLow.String(),
+		"report only diagnostics at or above this confidence: low, medium (SSA-traced) or high (proved from the AST)")
+	Analyzer.Flags.StringVar(&fireAndForgetFuncs, "fire-and-forget-funcs", "",
+		"comma-separated list of functions (e.g., pkg.Func or pkg.Type.Method) whose goroutines need no context when they call nothing else")
+	Analyzer.Flags.StringVar(&libSpecsFile, "lib-specs", "",
+		"path to a library spec bundle (e.g., ctxrelay-libs.yaml) extending the spawner, deriver, carrier and other function list flags")
+	Analyzer.Flags.BoolVar(&explainMissingDeriver, "explain-missing-deriver", false,
+		"with -goroutine-deriver, name the missing functions when a goroutine calls only part of an AND group (A+B)")
+	Analyzer.Flags.BoolVar(&trackStructCtxFields, "track-struct-ctx-fields", false,
+		"treat receiver struct context fields (e.g., s.ctx) as in scope, counting closures that read them or call methods reading them as propagating context")
+	Analyzer.Flags.BoolVar(&recognizeAccessors, "recognize-context-accessors", false,
+		"count closures obtaining their own context from a function outside the context package (e.g., trace.ContextFromHeaders) and using it as propagating context")
+	Analyzer.Flags.BoolVar(&gotaskDeriverFirst, "gotask-deriver-first", false,
+		"with -goroutine-deriver, require gotask task closures to call the deriver in their first statement")
+	Analyzer.Flags.BoolVar(&exportedOnly, "exported-only", false,
+		"check only exported top-level functions and the closures nested in them, skipping unexported helpers")
+	Analyzer.Flags.BoolVar(&trackLocalContexts, "track-local-contexts", false,
+		"treat context variables introduced by short variable declarations and type switches as in scope, not just parameters")
 
 	// Checker flags (default: all enabled)
 	Analyzer.Flags.BoolVar(&enableGoroutine, "goroutine", true, "enable goroutine checker")
@@ -54,66 +159,176 @@ func init() {
 	Analyzer.Flags.BoolVar(&enableConc, "conc", true, "enable conc (sourcegraph/conc) checker")
 	Analyzer.Flags.BoolVar(&enableSpawner, "spawner", true, "enable spawner checker")
 	Analyzer.Flags.BoolVar(&enableSpawnerlabel, "spawnerlabel", false, "enable spawnerlabel checker")
-	Analyzer.Flags.BoolVar(&enableGotask, "gotask", true, "enable gotask checker (requires -goroutine-deriver)")
+	Analyzer.Flags.BoolVar(&enableGotask, "gotask", true, "enable gotask checker (requires -goroutine-deriver or -deriver-packages)")
+
+	// Opt-in rules (default: disabled)
+	Analyzer.Flags.BoolVar(&enableSignal, "signal", false, "report signal.Notify where a context is in scope (use signal.NotifyContext instead)")
+	Analyzer.Flags.BoolVar(&enableNilCtx, "flag-nil-ctx", false, "report context.Context assigned or compared to nil")
+	Analyzer.Flags.BoolVar(&allowNilCtxGuard, "allow-nil-ctx-guard", true, "with -flag-nil-ctx, allow the \"if ctx == nil { ctx = ... }\" defaulting guard")
+	Analyzer.Flags.BoolVar(&enableGroupCtx, "flag-unused-group-ctx", false, "report errgroup.WithContext whose returned context is never used")
+	Analyzer.Flags.BoolVar(&enableTimeTick, "flag-time-tick", false, "report time.Tick where a context is in scope (use time.NewTicker with ctx.Done() instead)")
+	Analyzer.Flags.BoolVar(&enableWithoutCancel, "flag-without-cancel", false, "report context.WithoutCancel inside goroutines spawned where a context is in scope")
+	Analyzer.Flags.BoolVar(&enableReturnCtxErr, "errgroup-return-ctx-err", false, "require errgroup closures to return ctx.Err() from ctx.Done() cases in unbounded loops")
+	Analyzer.Flags.BoolVar(&enableBlockingIO, "flag-blocking-io", false, "report blocking I/O calls inside goroutines spawned where a context is in scope")
+	Analyzer.Flags.StringVar(&blockingFuncs, "blocking-funcs", checkers.DefaultBlockingFuncs,
+		"with -flag-blocking-io, comma-separated list of blocking functions (e.g., pkg.Func or pkg.Type.Method)")
+	Analyzer.Flags.BoolVar(&enableCtxInSlice, "flag-ctx-stored-in-slice", false, "report contexts appended to slices or assigned into maps inside goroutines")
+	Analyzer.Flags.BoolVar(&enableCtxChanSend, "flag-ctx-channel-send", false, "report contexts sent on channels from inside goroutines")
+	Analyzer.Flags.BoolVar(&enableUseAfterCancel, "flag-use-after-cancel", false, "report derived contexts passed to calls after their cancel function was called")
+	Analyzer.Flags.BoolVar(&enableExecCommand, "flag-exec-command", false, "report exec.Command where a context is in scope (use exec.CommandContext instead)")
+	Analyzer.Flags.BoolVar(&enableSlogStructCtx, "slog-struct-ctx", false, "report slog calls without ...Context where a context is in scope, treating receiver struct context fields (e.g., s.ctx) as in scope")
+	Analyzer.Flags.BoolVar(&enableRedundantDerive, "flag-redundant-derive", false, "report goroutines calling the goroutine deriver more than once (requires -goroutine-deriver or -deriver-packages)")
+	Analyzer.Flags.BoolVar(&enableWaitError, "errgroup-check-wait-error", false, "report errgroup.Wait() errors discarded with _ where a context is in scope")
+	Analyzer.Flags.BoolVar(&enableRequestCtx, "flag-request-background", false, "report HTTP handlers replacing the request context with context.Background() or context.TODO()")
+	Analyzer.Flags.BoolVar(&enableStaleCtx, "flag-stale-ctx", false, "report goroutines calling methods that use a receiver context field instead of the context in scope")
+	Analyzer.Flags.BoolVar(&enablePrintCtx, "flag-print-ctx", false, "report context.Context values formatted with %v by fmt print functions")
+	Analyzer.Flags.BoolVar(&enableIgnoredCtxErr, "flag-ignored-ctx-err", false, "report ctx.Err() calls whose result is discarded")
+	Analyzer.Flags.BoolVar(&enableLoopBackground, "flag-loop-background", false, "report context.Background() or context.TODO() created in loop bodies where a context is in scope")
+	Analyzer.Flags.BoolVar(&enableCtxInDTO, "flag-ctx-in-dto", false, "report context.Context fields in structs with json struct tags")
+	Analyzer.Flags.BoolVar(&enableCtxValueAssert, "flag-ctx-value-assert", false, "report type assertions on ctx.Value results without comma-ok")
+	Analyzer.Flags.BoolVar(&enableHandlerMap, "flag-ctxless-handler-map", false, "report func literals in dispatch maps whose handler type takes no context.Context and that ignore the context in scope")
+	Analyzer.Flags.BoolVar(&enableCtxParamField, "flag-ctx-param-field-conflict", false, "report methods whose context parameter is unused while a receiver context field is read")
+	Analyzer.Flags.BoolVar(&enableCtxInCache, "flag-ctx-in-cache", false, "report contexts passed to cache Set functions (-cache-set-funcs)")
+	Analyzer.Flags.StringVar(&cacheSetFuncs, "cache-set-funcs", checkers.DefaultCacheSetFuncs,
+		"with -flag-ctx-in-cache, comma-separated list of functions storing values in a long-lived cache (e.g., pkg.Func or pkg.Type.Method)")
+	Analyzer.Flags.BoolVar(&enableInitGoroutine, "flag-init-goroutines", false, "report goroutines spawned from init functions or package-level variable initializers")
+	Analyzer.Flags.BoolVar(&enableBackoff, "backoff", false, "report cenkalti/backoff Retry and RetryNotify calls whose policy is not wrapped with backoff.WithContext where a context is in scope")
+	Analyzer.Flags.BoolVar(&enableSyncOnce, "sync-once", false, "report sync.Once.Do callbacks that do not use the context in scope")
+	Analyzer.Flags.BoolVar(&enableCtxMapKey, "flag-ctx-map-key", false, "report context.Context values used as map keys")
+	Analyzer.Flags.BoolVar(&enableCtxInConstructor, "flag-ctx-stored-in-constructor", false, "report constructors storing their context parameter on the struct they return")
+	Analyzer.Flags.BoolVar(&enableStdLog, "flag-stdlog-in-goroutine", false, "report stdlib log and fmt print calls inside goroutines spawned where a context is in scope")
+	Analyzer.Flags.StringVar(&preferredLogger, "preferred-logger", "",
+		"with -flag-stdlog-in-goroutine, the context-aware logger function to suggest (e.g., log/slog.InfoContext)")
+	Analyzer.Flags.BoolVar(&enableUnguardedChanOp, "flag-unguarded-channel-op", false, "report channel sends and receives inside goroutines that are not in a select with ctx.Done()")
+	Analyzer.Flags.BoolVar(&enableCtxFreeVarStore, "flag-ctx-freevar-store", false, "report assignments inside goroutines to context variables captured from the spawning function")
+	Analyzer.Flags.BoolVar(&enableTransitive, "transitive", false, "report calls from context-aware code to same-package helpers that take no context but spawn goroutines or log, directly or through other helpers")
+	Analyzer.Flags.BoolVar(&enableLockedGoroutine, "flag-goroutine-under-lock", false, "report goroutines spawned and awaited while a sync.Mutex or sync.RWMutex is held")
+	Analyzer.Flags.BoolVar(&enableEscapingGoroutine, "flag-escaping-goroutine", false, "report bare go statements inside errgroup, sync.WaitGroup or conc closures, which escape the group")
 }
 
 // Analyzer is the main analyzer for goroutinectx.
 var Analyzer = &analysis.Analyzer{
 	Name:     "goroutinectx",
 	Doc:      "checks that context.Context is properly propagated to downstream calls",
-	Requires: []*analysis.Analyzer{inspect.Analyzer, ssa.BuildSSAAnalyzer},
+	Requires: []*analysis.Analyzer{inspect.Analyzer, ssa.BuildSSAAnalyzer, spawner.Analyzer},
 	Run:      run,
 	Flags:    flag.FlagSet{},
 }
 
 var ErrNoInspector = errors.New("inspector analyzer result not found")
 
+// unusedIgnoreCategory is the diagnostic category for unused ignore directives.
+// Every other diagnostic is categorized by the checker name that produced it.
+const unusedIgnoreCategory = "ignore"
+
+// configCategory is the diagnostic category for derivers and carriers
+// configured with a function or type their package does not declare.
+const configCategory = "config"
+
 func run(pass *analysis.Pass) (any, error) {
+	return runWithOptions(pass, Options{})
+}
+
+// runWithOptions runs the analysis with the built-in checkers plus the
+// registered custom checkers and those in opts.
+func runWithOptions(pass *analysis.Pass, opts Options) (any, error) {
 	insp, ok := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 	if !ok {
 		return nil, ErrNoInspector
 	}
 
+	style, err := checkers.ParseMessageStyle(messageStyle)
+	if err != nil {
+		return nil, err
+	}
+	confidence, err := internal.ParseConfidence(minConfidence)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the library spec bundle extending the function list flags
+	var libs *libspec.Bundle
+	if libSpecsFile != "" {
+		if libs, err = libspec.Load(libSpecsFile); err != nil {
+			return nil, err
+		}
+	}
+
 	// Build set of files to skip
 	skipFiles := buildSkipFiles(pass)
 
 	// Parse configuration
-	carriers := carrier.Parse(contextCarriers)
+	carriers := carrier.Parse(libs.Join(libspec.Carriers, contextCarriers))
 
 	// Build ignore maps for each file (excluding skipped files)
 	ignoreMaps := buildIgnoreMaps(pass, skipFiles)
 
 	// Build spawner map from //goroutinectx:spawner directives and -external-spawner flag
-	spawners := spawner.Build(pass, externalSpawner)
+	spawners := spawner.Build(pass, libs.Join(libspec.Spawners, externalSpawner))
+
+	// Collect custom checkers
+	customGoStmt, customCall := customCheckers(opts)
 
 	// Build enabled checkers map
-	enabled := buildEnabledCheckers(spawners)
+	enabled := buildEnabledCheckers(spawners, libs)
+	for _, c := range customGoStmt {
+		enabled[c.Name()] = true
+	}
+	for _, c := range customCall {
+		enabled[c.Name()] = true
+	}
+
+	// Apply per-directory .goroutinectx.yaml overrides
+	fileEnabled, err := buildFileEnabled(pass, skipFiles, enabled)
+	if err != nil {
+		return nil, err
+	}
+	dirEnabled := unionEnabled(fileEnabled)
 
 	// Build SSA program
 	ssaProg := ssa.Build(pass)
 
 	// Build derivers matcher
 	var derivers *deriver.Matcher
-	if goroutineDeriver != "" {
-		derivers = deriver.NewMatcher(goroutineDeriver)
+	if spec := deriverSpec(libs); spec != "" {
+		derivers = deriver.NewMatcher(spec)
 	}
 
+	// Report configured derivers and carriers that can never match
+	reportUndefinedSpecs(pass, derivers, carriers)
+
 	// Build checkers
-	goStmtCheckers, callCheckers := buildCheckers(derivers, spawners)
+	goStmtCheckers, callCheckers, nodeCheckers := buildCheckers(derivers, spawners, dirEnabled, style, libs)
+	goStmtCheckers = append(goStmtCheckers, customGoStmt...)
+	callCheckers = append(callCheckers, customCall...)
+
+	// Check arguments marked by //goroutinectx:check-arg directives
+	if checkArgMaps := buildCheckArgMaps(pass, skipFiles); len(checkArgMaps) > 0 {
+		callCheckers = append(callCheckers, checkers.NewCheckArg(checkArgMaps, derivers))
+	}
+
+	// Build set of functions marked with //goroutinectx:strict
+	strictFuncs := strict.Build(pass, skipFiles)
 
 	// Create and run runner
-	runner := internal.NewRunner(
-		goStmtCheckers,
-		callCheckers,
-		ssaProg,
-		carriers,
-		ignoreMaps,
-		skipFiles,
-	)
+	runner := internal.NewRunner(goStmtCheckers, callCheckers, nodeCheckers, ssaProg, internal.RunnerOptions{
+		Carriers:      carriers,
+		IgnoreMaps:    ignoreMaps,
+		SkipFiles:     skipFiles,
+		FileEnabled:   fileEnabled,
+		ReceiverCtx:   enableSlogStructCtx || trackStructCtxFields,
+		StructFields:  trackStructCtxFields,
+		Accessors:     recognizeAccessors,
+		Verbose:       verboseMessages,
+		StrictFuncs:   strictFuncs,
+		ExportedOnly:  exportedOnly,
+		LocalCtx:      trackLocalContexts,
+		MinConfidence: confidence,
+	})
 	runner.Run(pass, insp)
 
 	// Run spawnerlabel checker if enabled
-	if enableSpawnerlabel {
+	if enableSpawnerlabel || dirEnabled[ignore.Spawnerlabel] {
 		reg := registry.New()
 
 		// Register APIs for spawnerlabel detection
@@ -123,15 +338,90 @@ func run(pass *analysis.Pass) (any, error) {
 		internal.RegisterGotaskAPIs(reg)
 
 		spawnerlabelChecker := spawnerlabel.New(spawners, reg, ssaProg)
-		spawnerlabelChecker.Check(pass, ignoreMaps, skipFiles)
+		spawnerlabelChecker.Check(pass, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.Spawnerlabel))
+	}
+
+	// Run requestctx checker if enabled
+	if enableRequestCtx || dirEnabled[ignore.RequestCtx] {
+		requestctx.New().Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.RequestCtx))
+	}
+
+	// Run ctxindto checker if enabled
+	if enableCtxInDTO || dirEnabled[ignore.CtxInDTO] {
+		ctxindto.New().Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.CtxInDTO))
+	}
+
+	// Run ctxvalueassert checker if enabled
+	if enableCtxValueAssert || dirEnabled[ignore.CtxValueAssert] {
+		ctxvalueassert.New().Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.CtxValueAssert))
+	}
+
+	// Run ctxparamfield checker if enabled
+	if enableCtxParamField || dirEnabled[ignore.CtxParamField] {
+		ctxparamfield.New().Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.CtxParamField))
+	}
+
+	// Run initgoroutine checker if enabled
+	if enableInitGoroutine || dirEnabled[ignore.InitGoroutine] {
+		initgoroutine.New().Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.InitGoroutine))
+	}
+
+	// Run escapinggoroutine checker if enabled
+	if enableEscapingGoroutine || dirEnabled[ignore.EscapingGoroutine] {
+		reg := registry.New()
+		internal.RegisterErrgroupAPIs(reg)
+		internal.RegisterWaitgroupAPIs(reg)
+		internal.RegisterConcAPIs(reg)
+		escapinggoroutine.New(reg).Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.EscapingGoroutine))
+	}
+
+	// Run ctxmapkey checker if enabled
+	if enableCtxMapKey || dirEnabled[ignore.CtxMapKey] {
+		ctxmapkey.New().Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.CtxMapKey))
+	}
+
+	// Run ctxinconstructor checker if enabled
+	if enableCtxInConstructor || dirEnabled[ignore.CtxInConstructor] {
+		ctxinconstructor.New().Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.CtxInConstructor))
+	}
+
+	// Run lockedgoroutine checker if enabled
+	if enableLockedGoroutine || dirEnabled[ignore.LockedGoroutine] {
+		lockedgoroutine.New().Check(pass, insp, ignoreMaps, skipFilesFor(skipFiles, fileEnabled, ignore.LockedGoroutine))
 	}
 
 	// Report unused ignore directives
-	reportUnusedIgnores(pass, ignoreMaps, enabled)
+	reportUnusedIgnores(pass, ignoreMaps, enabled, fileEnabled)
 
 	return nil, nil
 }
 
+// reportUndefinedSpecs reports each configured deriver or carrier naming a
+// function or type its package lacks, at the import of that package.
+func reportUndefinedSpecs(pass *analysis.Pass, derivers *deriver.Matcher, carriers []carrier.Carrier) {
+	specs := append(configcheck.Derivers(derivers), configcheck.Carriers(carriers)...)
+	for _, warning := range configcheck.Undefined(specs, []*types.Package{pass.Pkg}) {
+		pass.Report(analysis.Diagnostic{
+			Pos:      importPos(pass, warning.Pkg),
+			Category: configCategory,
+			Message:  warning.Message,
+		})
+	}
+}
+
+// importPos returns the position of the first import of pkg, or of the
+// package clause when pkg is the analyzed package itself.
+func importPos(pass *analysis.Pass, pkg *types.Package) token.Pos {
+	for _, file := range pass.Files {
+		for _, spec := range file.Imports {
+			if path, err := strconv.Unquote(spec.Path.Value); err == nil && path == pkg.Path() {
+				return spec.Pos()
+			}
+		}
+	}
+	return pass.Files[0].Package
+}
+
 // buildSkipFiles creates a set of filenames to skip.
 func buildSkipFiles(pass *analysis.Pass) map[string]bool {
 	skipFiles := make(map[string]bool)
@@ -162,55 +452,198 @@ func buildIgnoreMaps(pass *analysis.Pass, skipFiles map[string]bool) map[string]
 	return ignoreMaps
 }
 
+// buildCheckArgMaps collects //goroutinectx:check-arg directives for each file
+// in the pass, omitting files without any.
+func buildCheckArgMaps(pass *analysis.Pass, skipFiles map[string]bool) map[string]checkarg.Map {
+	checkArgMaps := make(map[string]checkarg.Map)
+
+	for _, file := range pass.Files {
+		filename := pass.Fset.Position(file.Pos()).Filename
+		if skipFiles[filename] {
+			continue
+		}
+		if m := checkarg.Build(pass.Fset, file); len(m) > 0 {
+			checkArgMaps[filename] = m
+		}
+	}
+
+	return checkArgMaps
+}
+
 // buildCheckers creates the checker instances.
-func buildCheckers(derivers *deriver.Matcher, spawners *spawner.Map) ([]internal.GoStmtChecker, []internal.CallChecker) {
+// Checkers enabled by dirEnabled are created even if their flag is off;
+// the runner then limits them to the files whose config enables them.
+func buildCheckers(derivers *deriver.Matcher, spawners *spawner.Map, dirEnabled ignore.EnabledCheckers, style checkers.MessageStyle, libs *libspec.Bundle) ([]internal.GoStmtChecker, []internal.CallChecker, []internal.NodeChecker) {
 	var goStmtCheckers []internal.GoStmtChecker
 	var callCheckers []internal.CallChecker
+	var nodeCheckers []internal.NodeChecker
 
 	// Goroutine checkers
-	if enableGoroutine {
-		goStmtCheckers = append(goStmtCheckers, &checkers.Goroutine{})
+	if enableGoroutine || dirEnabled[ignore.Goroutine] {
+		goStmtCheckers = append(goStmtCheckers, checkers.NewGoroutine(style, libs.Join(libspec.FireAndForget, fireAndForgetFuncs)))
 	}
 
 	if derivers != nil {
-		goStmtCheckers = append(goStmtCheckers, checkers.NewGoroutineDerive(derivers))
+		goStmtCheckers = append(goStmtCheckers, checkers.NewGoroutineDerive(derivers, style, explainMissingDeriver))
+	}
+
+	if (enableRedundantDerive || dirEnabled[ignore.RedundantDerive]) && derivers != nil {
+		goStmtCheckers = append(goStmtCheckers, checkers.NewRedundantDerive(derivers))
+	}
+
+	if enableStaleCtx || dirEnabled[ignore.StaleCtx] {
+		goStmtCheckers = append(goStmtCheckers, &checkers.StaleCtx{})
 	}
 
 	// Call checkers
-	if enableErrgroup {
+	if enableErrgroup || dirEnabled[ignore.Errgroup] {
 		callCheckers = append(callCheckers, checkers.NewErrgroupChecker(derivers))
 	}
 
-	if enableWaitgroup {
+	if enableWaitgroup || dirEnabled[ignore.Waitgroup] {
 		callCheckers = append(callCheckers, checkers.NewWaitgroupChecker(derivers))
 	}
 
-	if enableConc {
+	if enableConc || dirEnabled[ignore.Errgroup] {
 		callCheckers = append(callCheckers, checkers.NewConcChecker(derivers))
 	}
 
-	if enableSpawner && spawners.Len() > 0 {
+	if (enableSpawner || dirEnabled[ignore.Spawner]) && spawners.Len() > 0 {
 		callCheckers = append(callCheckers, checkers.NewSpawnerChecker(spawners, derivers))
 	}
 
-	if enableGotask && derivers != nil {
-		if gotaskChecker := checkers.NewGotaskChecker(derivers); gotaskChecker != nil {
+	if (enableGotask || dirEnabled[ignore.Gotask]) && derivers != nil {
+		if gotaskChecker := checkers.NewGotaskChecker(derivers, gotaskDeriverFirst, libs.Join(libspec.AsyncLaunchers, asyncLaunchers)); gotaskChecker != nil {
 			callCheckers = append(callCheckers, gotaskChecker)
 		}
 	}
 
-	return goStmtCheckers, callCheckers
+	if enableSignal || dirEnabled[ignore.Signal] {
+		callCheckers = append(callCheckers, &checkers.Signal{})
+	}
+
+	if enableGroupCtx || dirEnabled[ignore.GroupCtx] {
+		callCheckers = append(callCheckers, &checkers.UnusedGroupCtx{})
+	}
+
+	if enableTimeTick || dirEnabled[ignore.TimeTick] {
+		callCheckers = append(callCheckers, &checkers.TimeTick{})
+	}
+
+	if enableWithoutCancel || dirEnabled[ignore.WithoutCancel] {
+		callCheckers = append(callCheckers, &checkers.WithoutCancel{})
+	}
+
+	if enableReturnCtxErr || dirEnabled[ignore.ReturnCtxErr] {
+		callCheckers = append(callCheckers, &checkers.ReturnCtxErr{})
+	}
+
+	if enableBlockingIO || dirEnabled[ignore.BlockingIO] {
+		callCheckers = append(callCheckers, checkers.NewBlockingIO(libs.Join(libspec.Blocking, blockingFuncs)))
+	}
+
+	if enableUseAfterCancel || dirEnabled[ignore.UseAfterCancel] {
+		callCheckers = append(callCheckers, &checkers.UseAfterCancel{})
+	}
+
+	if enableExecCommand || dirEnabled[ignore.ExecCommand] {
+		callCheckers = append(callCheckers, &checkers.ExecCommand{})
+	}
+
+	if enableSlogStructCtx || dirEnabled[ignore.SlogCtx] {
+		callCheckers = append(callCheckers, &checkers.SlogCtx{})
+	}
+
+	if enablePrintCtx || dirEnabled[ignore.PrintCtx] {
+		callCheckers = append(callCheckers, &checkers.PrintCtx{})
+	}
+
+	if enableLoopBackground || dirEnabled[ignore.LoopBackground] {
+		callCheckers = append(callCheckers, &checkers.LoopBackground{})
+	}
+
+	if enableCtxInCache || dirEnabled[ignore.CtxInCache] {
+		callCheckers = append(callCheckers, checkers.NewCtxInCache(libs.Join(libspec.CacheSet, cacheSetFuncs)))
+	}
+
+	if enableBackoff || dirEnabled[ignore.Backoff] {
+		callCheckers = append(callCheckers, &checkers.Backoff{})
+	}
+
+	if enableSyncOnce || dirEnabled[ignore.SyncOnce] {
+		callCheckers = append(callCheckers, checkers.NewSyncOnceChecker())
+	}
+
+	if enableStdLog || dirEnabled[ignore.StdLog] {
+		callCheckers = append(callCheckers, checkers.NewStdLog(preferredLogger))
+	}
+
+	if enableTransitive || dirEnabled[ignore.Transitive] {
+		callCheckers = append(callCheckers, checkers.NewTransitive())
+	}
+
+	if funcs := libs.Join(libspec.CtxRequired, ctxRequiredFuncs); funcs != "" {
+		callCheckers = append(callCheckers, checkers.NewCtxRequired(funcs))
+	}
+
+	if funcs := libs.Join(libspec.ClosureStore, closureStoreFuncs); funcs != "" {
+		callCheckers = append(callCheckers, checkers.NewClosureStore(funcs))
+	}
+
+	// Node checkers
+	if enableNilCtx || dirEnabled[ignore.NilCtx] {
+		nodeCheckers = append(nodeCheckers, checkers.NewNilCtx(allowNilCtxGuard))
+	}
+
+	if enableCtxInSlice || dirEnabled[ignore.CtxInSlice] {
+		nodeCheckers = append(nodeCheckers, &checkers.CtxInSlice{})
+	}
+
+	if enableCtxChanSend || dirEnabled[ignore.CtxChanSend] {
+		nodeCheckers = append(nodeCheckers, &checkers.CtxChanSend{})
+	}
+
+	if enableWaitError || dirEnabled[ignore.WaitError] {
+		nodeCheckers = append(nodeCheckers, &checkers.WaitError{})
+	}
+
+	if enableIgnoredCtxErr || dirEnabled[ignore.IgnoredCtxErr] {
+		nodeCheckers = append(nodeCheckers, &checkers.IgnoredCtxErr{})
+	}
+
+	if enableHandlerMap || dirEnabled[ignore.HandlerMap] {
+		nodeCheckers = append(nodeCheckers, &checkers.CtxlessHandlerMap{})
+	}
+
+	if enableUnguardedChanOp || dirEnabled[ignore.UnguardedChanOp] {
+		nodeCheckers = append(nodeCheckers, &checkers.UnguardedChanOp{})
+	}
+
+	if enableCtxFreeVarStore || dirEnabled[ignore.CtxFreeVarStore] {
+		nodeCheckers = append(nodeCheckers, &checkers.CtxFreeVarStore{})
+	}
+
+	return goStmtCheckers, callCheckers, nodeCheckers
+}
+
+// deriverSpec combines -goroutine-deriver and the derivers of libs with
+// package wildcards from -deriver-packages.
+func deriverSpec(libs *libspec.Bundle) string {
+	return deriver.WithPackages(libs.Join(libspec.Derivers, goroutineDeriver), deriverPackages)
 }
 
 // buildEnabledCheckers creates a map of which checkers are enabled.
-func buildEnabledCheckers(spawners *spawner.Map) ignore.EnabledCheckers {
+func buildEnabledCheckers(spawners *spawner.Map, libs *libspec.Bundle) ignore.EnabledCheckers {
 	enabled := make(ignore.EnabledCheckers)
 
+	// Directive-driven, so always enabled
+	enabled[ignore.CheckArg] = true
+
 	if enableGoroutine {
 		enabled[ignore.Goroutine] = true
 	}
 
-	if goroutineDeriver != "" {
+	if deriverSpec(libs) != "" {
 		enabled[ignore.GoroutineDerive] = true
 	}
 
@@ -230,26 +663,250 @@ func buildEnabledCheckers(spawners *spawner.Map) ignore.EnabledCheckers {
 		enabled[ignore.Spawnerlabel] = true
 	}
 
-	if goroutineDeriver != "" && enableGotask {
+	if deriverSpec(libs) != "" && enableGotask {
 		enabled[ignore.Gotask] = true
 	}
 
+	if enableSignal {
+		enabled[ignore.Signal] = true
+	}
+
+	if enableNilCtx {
+		enabled[ignore.NilCtx] = true
+	}
+
+	if enableGroupCtx {
+		enabled[ignore.GroupCtx] = true
+	}
+
+	if enableTimeTick {
+		enabled[ignore.TimeTick] = true
+	}
+
+	if enableWithoutCancel {
+		enabled[ignore.WithoutCancel] = true
+	}
+
+	if enableReturnCtxErr {
+		enabled[ignore.ReturnCtxErr] = true
+	}
+
+	if enableBlockingIO {
+		enabled[ignore.BlockingIO] = true
+	}
+
+	if enableCtxInSlice {
+		enabled[ignore.CtxInSlice] = true
+	}
+
+	if enableCtxChanSend {
+		enabled[ignore.CtxChanSend] = true
+	}
+
+	if enableUseAfterCancel {
+		enabled[ignore.UseAfterCancel] = true
+	}
+
+	if enableExecCommand {
+		enabled[ignore.ExecCommand] = true
+	}
+
+	if enableSlogStructCtx {
+		enabled[ignore.SlogCtx] = true
+	}
+
+	if deriverSpec(libs) != "" && enableRedundantDerive {
+		enabled[ignore.RedundantDerive] = true
+	}
+
+	if enableWaitError {
+		enabled[ignore.WaitError] = true
+	}
+
+	if enableRequestCtx {
+		enabled[ignore.RequestCtx] = true
+	}
+
+	if enableStaleCtx {
+		enabled[ignore.StaleCtx] = true
+	}
+
+	if enablePrintCtx {
+		enabled[ignore.PrintCtx] = true
+	}
+
+	if enableIgnoredCtxErr {
+		enabled[ignore.IgnoredCtxErr] = true
+	}
+
+	if enableLoopBackground {
+		enabled[ignore.LoopBackground] = true
+	}
+
+	if enableCtxInDTO {
+		enabled[ignore.CtxInDTO] = true
+	}
+
+	if enableCtxValueAssert {
+		enabled[ignore.CtxValueAssert] = true
+	}
+
+	if enableHandlerMap {
+		enabled[ignore.HandlerMap] = true
+	}
+
+	if enableCtxParamField {
+		enabled[ignore.CtxParamField] = true
+	}
+
+	if enableCtxInCache {
+		enabled[ignore.CtxInCache] = true
+	}
+
+	if enableInitGoroutine {
+		enabled[ignore.InitGoroutine] = true
+	}
+
+	if enableEscapingGoroutine {
+		enabled[ignore.EscapingGoroutine] = true
+	}
+
+	if enableBackoff {
+		enabled[ignore.Backoff] = true
+	}
+
+	if enableSyncOnce {
+		enabled[ignore.SyncOnce] = true
+	}
+
+	if enableCtxMapKey {
+		enabled[ignore.CtxMapKey] = true
+	}
+
+	if enableCtxInConstructor {
+		enabled[ignore.CtxInConstructor] = true
+	}
+
+	if enableStdLog {
+		enabled[ignore.StdLog] = true
+	}
+
+	if enableUnguardedChanOp {
+		enabled[ignore.UnguardedChanOp] = true
+	}
+
+	if enableCtxFreeVarStore {
+		enabled[ignore.CtxFreeVarStore] = true
+	}
+
+	if enableTransitive {
+		enabled[ignore.Transitive] = true
+	}
+
+	if enableLockedGoroutine {
+		enabled[ignore.LockedGoroutine] = true
+	}
+
+	if libs.Join(libspec.CtxRequired, ctxRequiredFuncs) != "" {
+		enabled[ignore.CtxRequired] = true
+	}
+
+	if libs.Join(libspec.ClosureStore, closureStoreFuncs) != "" {
+		enabled[ignore.ClosureStore] = true
+	}
+
 	return enabled
 }
 
+// buildFileEnabled resolves .goroutinectx.yaml overrides for each file.
+// Returns nil if no file in the pass is affected by a config file.
+func buildFileEnabled(pass *analysis.Pass, skipFiles map[string]bool, enabled ignore.EnabledCheckers) (map[string]ignore.EnabledCheckers, error) {
+	fileEnabled := make(map[string]ignore.EnabledCheckers)
+	dirOverrides := make(map[string]dirconfig.Overrides)
+	found := false
+
+	for _, file := range pass.Files {
+		filename := pass.Fset.Position(file.Pos()).Filename
+		if skipFiles[filename] {
+			continue
+		}
+
+		dir := filepath.Dir(filename)
+		overrides, ok := dirOverrides[dir]
+		if !ok {
+			var err error
+			if overrides, err = dirconfig.Resolve(dir); err != nil {
+				return nil, err
+			}
+			dirOverrides[dir] = overrides
+		}
+
+		if overrides == nil {
+			fileEnabled[filename] = enabled
+			continue
+		}
+		found = true
+
+		fileEnabled[filename] = overrides.Apply(enabled)
+	}
+
+	if !found {
+		return nil, nil
+	}
+	return fileEnabled, nil
+}
+
+// unionEnabled returns the checkers enabled in at least one file.
+func unionEnabled(fileEnabled map[string]ignore.EnabledCheckers) ignore.EnabledCheckers {
+	union := make(ignore.EnabledCheckers)
+	for _, enabled := range fileEnabled {
+		for name, on := range enabled {
+			if on {
+				union[name] = true
+			}
+		}
+	}
+	return union
+}
+
+// skipFilesFor extends skipFiles with the files where the checker is disabled.
+func skipFilesFor(skipFiles map[string]bool, fileEnabled map[string]ignore.EnabledCheckers, name ignore.CheckerName) map[string]bool {
+	if fileEnabled == nil {
+		return skipFiles
+	}
+	result := make(map[string]bool, len(skipFiles))
+	for filename := range skipFiles {
+		result[filename] = true
+	}
+	for filename, enabled := range fileEnabled {
+		if !enabled[name] {
+			result[filename] = true
+		}
+	}
+	return result
+}
+
 // reportUnusedIgnores reports any ignore directives that were not used.
-func reportUnusedIgnores(pass *analysis.Pass, ignoreMaps map[string]ignore.Map, enabled ignore.EnabledCheckers) {
-	for _, ignoreMap := range ignoreMaps {
-		for _, unused := range ignoreMap.GetUnusedIgnores(enabled) {
-			if len(unused.Checkers) == 0 {
-				pass.Reportf(unused.Pos, "unused goroutinectx:ignore directive")
-			} else {
+func reportUnusedIgnores(pass *analysis.Pass, ignoreMaps map[string]ignore.Map, enabled ignore.EnabledCheckers, fileEnabled map[string]ignore.EnabledCheckers) {
+	for filename, ignoreMap := range ignoreMaps {
+		checkersEnabled := enabled
+		if e, ok := fileEnabled[filename]; ok {
+			checkersEnabled = e
+		}
+		for _, unused := range ignoreMap.GetUnusedIgnores(checkersEnabled) {
+			msg := "unused goroutinectx:ignore directive"
+			if len(unused.Checkers) > 0 {
 				checkerNames := make([]string, len(unused.Checkers))
 				for i, c := range unused.Checkers {
 					checkerNames[i] = string(c)
 				}
-				pass.Reportf(unused.Pos, "unused goroutinectx:ignore directive for checker(s): %s", strings.Join(checkerNames, ", "))
+				msg += " for checker(s): " + strings.Join(checkerNames, ", ")
 			}
+			pass.Report(analysis.Diagnostic{
+				Pos:      unused.Pos,
+				Category: unusedIgnoreCategory,
+				Message:  msg,
+			})
 		}
 	}
 }
@@ -1,8 +1,15 @@
 package goroutinectx_test
 
 import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
 	"testing"
 
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
 
 	"github.com/mpyw/goroutinectx"
@@ -53,6 +60,20 @@ func TestGoroutineDerive(t *testing.T) {
 	analysistest.Run(t, testdata, goroutinectx.Analyzer, "goroutinederive")
 }
 
+func TestGoroutineDerivePackages(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("deriver-packages", "github.com/my-example-app/telemetry/apm"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("deriver-packages", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "goroutinederivepkg")
+}
+
 func TestGoroutineDeriveAnd(t *testing.T) {
 	testdata := analysistest.TestData()
 	// AND: all must be called (Transaction.NewGoroutine + NewContext)
@@ -86,6 +107,130 @@ func TestGoroutineDeriveMixed(t *testing.T) {
 	analysistest.Run(t, testdata, goroutinectx.Analyzer, "goroutinederivemixed")
 }
 
+func TestGoroutineDeriveMixedRelated(t *testing.T) {
+	testdata := analysistest.TestData()
+	// Mixed: (Transaction.NewGoroutine AND NewContext) OR apm.NewGoroutineContext
+	deriveFunc := "github.com/newrelic/go-agent/v3/newrelic.Transaction.NewGoroutine+" +
+		"github.com/newrelic/go-agent/v3/newrelic.NewContext," +
+		"github.com/my-example-app/telemetry/apm.NewGoroutineContext"
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", deriveFunc); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+	}()
+
+	// enclosing func name -> related messages of its diagnostic
+	got := make(map[string][]string)
+	for _, result := range analysistest.Run(t, testdata, goroutinectx.Analyzer, "goroutinederivemixed") {
+		for _, diag := range result.Diagnostics {
+			name := enclosingFuncName(result.Pass, diag.Pos)
+			for _, rel := range diag.Related {
+				got[name] = append(got[name], rel.Message)
+			}
+		}
+	}
+
+	want := map[string][]string{
+		"badMixedOnlyFirstOfAnd": {
+			"missing deriver newrelic.NewContext",
+			"present deriver newrelic.Transaction.NewGoroutine",
+			"context declared here",
+		},
+		"badMixedOnlySecondOfAnd": {
+			"missing deriver newrelic.Transaction.NewGoroutine",
+			"present deriver newrelic.NewContext",
+			"context declared here",
+		},
+		"badMixedCallsNothing": {
+			"context declared here",
+		},
+	}
+	for name, messages := range want {
+		if fmt.Sprint(got[name]) != fmt.Sprint(messages) {
+			t.Errorf("%s: related = %q, want %q", name, got[name], messages)
+		}
+	}
+}
+
+func TestContextDeclaredRelated(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	found := false
+	for _, result := range analysistest.Run(t, testdata, goroutinectx.Analyzer, "goroutine") {
+		for _, diag := range result.Diagnostics {
+			if enclosingFuncName(result.Pass, diag.Pos) != "badGoroutineNoCapture" {
+				continue
+			}
+			found = true
+
+			if len(diag.Related) == 0 {
+				t.Fatalf("%s: no related information", diag.Message)
+			}
+			rel := diag.Related[len(diag.Related)-1]
+			if rel.Message != "context declared here" {
+				t.Errorf("related message = %q, want %q", rel.Message, "context declared here")
+			}
+			if got, want := result.Pass.Fset.Position(rel.Pos), ctxParamPosition(result.Pass, diag.Pos); got != want {
+				t.Errorf("related position = %v, want ctx parameter at %v", got, want)
+			}
+		}
+	}
+	if !found {
+		t.Fatal("no diagnostic in badGoroutineNoCapture")
+	}
+}
+
+func TestSpawnerDiagnosticsThroughRunner(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	found := false
+	for _, result := range analysistest.Run(t, testdata, goroutinectx.Analyzer, "spawner") {
+		for _, diag := range result.Diagnostics {
+			if enclosingFuncName(result.Pass, diag.Pos) != "badMultipleFuncs" {
+				continue
+			}
+			found = true
+
+			if diag.Category != "spawner" {
+				t.Errorf("%s: category = %q, want %q", diag.Message, diag.Category, "spawner")
+			}
+			if len(diag.Related) == 0 || diag.Related[len(diag.Related)-1].Message != "context declared here" {
+				t.Errorf("%s: related = %v, want a context declaration note", diag.Message, diag.Related)
+			}
+		}
+	}
+	if !found {
+		t.Fatal("no diagnostic in badMultipleFuncs")
+	}
+}
+
+// ctxParamPosition returns the position of the first parameter of the
+// function declaration containing pos.
+func ctxParamPosition(pass *analysis.Pass, pos token.Pos) token.Position {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Pos() <= pos && pos < fn.End() {
+				return pass.Fset.Position(fn.Type.Params.List[0].Names[0].Pos())
+			}
+		}
+	}
+	return token.Position{}
+}
+
+// enclosingFuncName returns the name of the function declaration containing pos.
+func enclosingFuncName(pass *analysis.Pass, pos token.Pos) string {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Pos() <= pos && pos < fn.End() {
+				return fn.Name.Name
+			}
+		}
+	}
+	return ""
+}
+
 func TestContextCarriers(t *testing.T) {
 	testdata := analysistest.TestData()
 
@@ -142,12 +287,28 @@ func TestSpawner(t *testing.T) {
 	analysistest.Run(t, testdata, goroutinectx.Analyzer, "spawner")
 }
 
+func TestSpawnerWithDeriver(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	deriveFunc := "github.com/my-example-app/telemetry/apm.NewGoroutineContext"
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", deriveFunc); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "spawner/derive")
+}
+
 func TestExternalSpawner(t *testing.T) {
 	testdata := analysistest.TestData()
 
 	// Set external spawner flag for workerpool package
 	externalSpawners := "github.com/example/workerpool.Pool.Submit," +
-		"github.com/example/workerpool.Run"
+		"github.com/example/workerpool.Run," +
+		"time.AfterFunc"
 	if err := goroutinectx.Analyzer.Flags.Set("external-spawner", externalSpawners); err != nil {
 		t.Fatal(err)
 	}
@@ -159,6 +320,21 @@ func TestExternalSpawner(t *testing.T) {
 	analysistest.Run(t, testdata, goroutinectx.Analyzer, "externalspawner")
 }
 
+func TestSpawnerFacts(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "spawnerfacts/worker", "spawnerfacts/handler")
+}
+
+func TestDirConfig(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, goroutinectx.Analyzer,
+		"dirconfig",
+		"dirconfig/legacy",
+		"dirconfig/legacy/strict",
+		"dirconfig/signals",
+	)
+}
+
 func TestSpawnerlabel(t *testing.T) {
 	testdata := analysistest.TestData()
 
@@ -188,8 +364,1078 @@ func TestGotask(t *testing.T) {
 	analysistest.Run(t, testdata, goroutinectx.Analyzer, "gotask")
 }
 
+func TestAsyncLaunchers(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	deriveFunc := "github.com/my-example-app/telemetry/apm.NewGoroutineContext"
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", deriveFunc); err != nil {
+		t.Fatal(err)
+	}
+	launchers := "github.com/example/asyncjob.Job.Start:WithContext," +
+		"github.com/example/asyncjob.Job.Run:1"
+	if err := goroutinectx.Analyzer.Flags.Set("async-launchers", launchers); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+		_ = goroutinectx.Analyzer.Flags.Set("async-launchers", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "asynclauncher")
+}
+
 func TestFileFilter(t *testing.T) {
 	testdata := analysistest.TestData()
 	// Tests that generated files are skipped
 	analysistest.Run(t, testdata, goroutinectx.Analyzer, "filefilter")
 }
+
+func TestSignal(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("signal", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("track-struct-ctx-fields", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("context-carriers", "github.com/labstack/echo/v4.Context"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("signal", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("track-struct-ctx-fields", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("context-carriers", "")
+	}()
+
+	analysistest.RunWithSuggestedFixes(t, testdata, goroutinectx.Analyzer, "signal")
+}
+
+func TestNilCtx(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-nil-ctx", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-nil-ctx", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "nilctx")
+}
+
+func TestNilCtxStrict(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-nil-ctx", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("allow-nil-ctx-guard", "false"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-nil-ctx", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("allow-nil-ctx-guard", "true")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "nilctxstrict")
+}
+
+func TestGroupCtx(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-unused-group-ctx", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-unused-group-ctx", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "groupctx")
+}
+
+func TestTimeTick(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-time-tick", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-time-tick", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "timetick")
+}
+
+func TestWithoutCancel(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-without-cancel", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-without-cancel", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "withoutcancel")
+}
+
+func TestReturnCtxErr(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("errgroup-return-ctx-err", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("errgroup-return-ctx-err", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "returnctxerr")
+}
+
+func TestBlockingIO(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-blocking-io", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-blocking-io", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "blockingio")
+}
+
+func TestBlockingIOCustom(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-blocking-io", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := goroutinectx.Analyzer.Flags.Set("blocking-funcs", "io.ReadFull"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-blocking-io", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("blocking-funcs", "io.Copy,io.ReadAll,bufio.Scanner.Scan")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "blockingiocustom")
+}
+
+func TestCtxInSlice(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-stored-in-slice", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-stored-in-slice", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxinslice")
+}
+
+func TestCtxChanSend(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-channel-send", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-channel-send", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxchansend")
+}
+
+func TestUseAfterCancel(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-use-after-cancel", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-use-after-cancel", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "useaftercancel")
+}
+
+func TestCtxRequired(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	funcs := "ctxrequired.requestFields:0,ctxrequired.Logger.Fields:1"
+	if err := goroutinectx.Analyzer.Flags.Set("ctx-required-funcs", funcs); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("ctx-required-funcs", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxrequired")
+}
+
+func TestMessageStyleUnified(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	deriveFunc := "github.com/my-example-app/telemetry/apm.NewGoroutineContext"
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", deriveFunc); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("message-style", "unified"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+		_ = goroutinectx.Analyzer.Flags.Set("message-style", "legacy")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "messagestyle")
+}
+
+func TestExecCommand(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-exec-command", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("context-carriers", "github.com/labstack/echo/v4.Context"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-exec-command", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("context-carriers", "")
+	}()
+
+	analysistest.RunWithSuggestedFixes(t, testdata, goroutinectx.Analyzer, "execcommand")
+}
+
+func TestSlogStructCtx(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("slog-struct-ctx", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("context-carriers", "github.com/labstack/echo/v4.Context"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("slog-struct-ctx", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("context-carriers", "")
+	}()
+
+	analysistest.RunWithSuggestedFixes(t, testdata, goroutinectx.Analyzer, "slogstructctx")
+}
+
+func TestRedundantDerive(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	deriveFunc := "github.com/my-example-app/telemetry/apm.NewGoroutineContext"
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", deriveFunc); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("flag-redundant-derive", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+		_ = goroutinectx.Analyzer.Flags.Set("flag-redundant-derive", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "redundantderive")
+}
+
+// fetchChecker flags calls to the bespoke customchecker.fetch API without a context.
+type fetchChecker struct{}
+
+func (fetchChecker) Name() string { return "legacyfetch" }
+
+func (fetchChecker) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	fn, ok := pass.TypesInfo.Uses[ident].(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == "customchecker" && fn.Name() == "fetch"
+}
+
+func (fetchChecker) CheckCall(cctx *goroutinectx.CheckContext, call *ast.CallExpr) string {
+	for _, arg := range call.Args {
+		if cctx.UsesContext(arg) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("fetch should receive context %q", cctx.CtxNames[0])
+}
+
+// closureGoChecker flags go statements spawning named functions.
+type closureGoChecker struct{}
+
+func (closureGoChecker) Name() string { return "closurego" }
+
+func (closureGoChecker) CheckGoStmt(_ *goroutinectx.CheckContext, stmt *ast.GoStmt) string {
+	if _, ok := stmt.Call.Fun.(*ast.FuncLit); ok {
+		return ""
+	}
+	return "spawn a closure instead of a named function"
+}
+
+func TestCustomCheckers(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	goroutinectx.Register(fetchChecker{})
+	t.Cleanup(goroutinectx.ResetCustomCheckers)
+
+	analyzer := goroutinectx.NewWithOptions(goroutinectx.Options{
+		GoStmtCheckers: []goroutinectx.GoStmtChecker{closureGoChecker{}},
+	})
+
+	analysistest.Run(t, testdata, analyzer, "customchecker")
+}
+
+// legacyGoChecker flags go statements spawning customgostmt.legacyWorker.
+type legacyGoChecker struct{}
+
+func (legacyGoChecker) Name() string { return "legacygo" }
+
+func (legacyGoChecker) CheckGoStmt(_ *goroutinectx.CheckContext, stmt *ast.GoStmt) string {
+	if ident, ok := stmt.Call.Fun.(*ast.Ident); !ok || ident.Name != "legacyWorker" {
+		return ""
+	}
+	return "legacyWorker is deprecated; spawn worker instead"
+}
+
+// diagnosticRecorder collects the errors analysistest reports, so that
+// expectations left unmet on purpose do not fail the test.
+type diagnosticRecorder struct{ errors []string }
+
+func (r *diagnosticRecorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRegisterGoStmt(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	goroutinectx.RegisterGoStmt(legacyGoChecker{})
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "customgostmt")
+
+	// Once unregistered, the checker no longer reports anything.
+	goroutinectx.ResetCustomCheckers()
+	for _, result := range analysistest.Run(&diagnosticRecorder{}, testdata, goroutinectx.Analyzer, "customgostmt") {
+		for _, d := range result.Diagnostics {
+			t.Errorf("unexpected diagnostic after reset: %s", d.Message)
+		}
+	}
+}
+
+func TestWaitError(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("errgroup-check-wait-error", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("errgroup-check-wait-error", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "waiterror")
+}
+
+func TestCheckArg(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "checkarg")
+}
+
+func TestRequestCtx(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-request-background", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-request-background", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "requestctx")
+}
+
+func TestStaleCtx(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-stale-ctx", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-stale-ctx", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "stalectx")
+}
+
+func TestPrintCtx(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-print-ctx", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-print-ctx", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "printctx")
+}
+
+func TestIgnoredCtxErr(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ignored-ctx-err", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ignored-ctx-err", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ignoredctxerr")
+}
+
+// TestAnalyzerFlags guards the flags that golangci-lint sets through the
+// analyzer settings. Every rule must be reachable through a flag.
+func TestAnalyzerFlags(t *testing.T) {
+	// Flags that configure checkers rather than enabling a rule.
+	configFlags := []string{
+		"goroutine-deriver",
+		"deriver-packages",
+		"external-spawner",
+		"context-carriers",
+		"blocking-funcs",
+		"cache-set-funcs",
+		"preferred-logger",
+		"allow-nil-ctx-guard",
+		"message-style",
+		"min-confidence",
+		"verbose-messages",
+		"fire-and-forget-funcs",
+		"lib-specs",
+		"explain-missing-deriver",
+		"track-struct-ctx-fields",
+		"recognize-context-accessors",
+		"gotask-deriver-first",
+		"async-launchers",
+		"exported-only",
+		"track-local-contexts",
+	}
+
+	// Flags enabling each rule. Rules without a flag of their own are
+	// enabled by the flag configuring them or by directives (empty).
+	ruleFlags := map[string]string{
+		"goroutine":         "goroutine",
+		"goroutinederive":   "goroutine-deriver",
+		"waitgroup":         "waitgroup",
+		"errgroup":          "errgroup",
+		"spawner":           "spawner",
+		"spawnerlabel":      "spawnerlabel",
+		"gotask":            "gotask",
+		"signal":            "signal",
+		"nilctx":            "flag-nil-ctx",
+		"groupctx":          "flag-unused-group-ctx",
+		"timetick":          "flag-time-tick",
+		"withoutcancel":     "flag-without-cancel",
+		"returnctxerr":      "errgroup-return-ctx-err",
+		"blockingio":        "flag-blocking-io",
+		"ctxinslice":        "flag-ctx-stored-in-slice",
+		"ctxchansend":       "flag-ctx-channel-send",
+		"useaftercancel":    "flag-use-after-cancel",
+		"ctxrequired":       "ctx-required-funcs",
+		"execcommand":       "flag-exec-command",
+		"slogctx":           "slog-struct-ctx",
+		"redundantderive":   "flag-redundant-derive",
+		"waiterror":         "errgroup-check-wait-error",
+		"checkarg":          "",
+		"requestctx":        "flag-request-background",
+		"stalectx":          "flag-stale-ctx",
+		"printctx":          "flag-print-ctx",
+		"ignoredctxerr":     "flag-ignored-ctx-err",
+		"loopbackground":    "flag-loop-background",
+		"ctxindto":          "flag-ctx-in-dto",
+		"ctxvalueassert":    "flag-ctx-value-assert",
+		"handlermap":        "flag-ctxless-handler-map",
+		"ctxparamfield":     "flag-ctx-param-field-conflict",
+		"ctxincache":        "flag-ctx-in-cache",
+		"initgoroutine":     "flag-init-goroutines",
+		"escapinggoroutine": "flag-escaping-goroutine",
+		"backoff":           "backoff",
+		"synconce":          "sync-once",
+		"ctxmapkey":         "flag-ctx-map-key",
+		"ctxinconstructor":  "flag-ctx-stored-in-constructor",
+		"stdlog":            "flag-stdlog-in-goroutine",
+		"unguardedchanop":   "flag-unguarded-channel-op",
+		"ctxfreevarstore":   "flag-ctx-freevar-store",
+		"transitive":        "transitive",
+		"lockedgoroutine":   "flag-goroutine-under-lock",
+		"closurestore":      "closure-store-funcs",
+		"config":            "",
+		"ignore":            "",
+	}
+
+	expected := map[string]bool{"conc": true}
+	for _, name := range configFlags {
+		expected[name] = true
+	}
+
+	for _, rule := range goroutinectx.Rules() {
+		name, ok := ruleFlags[rule.Category]
+		if !ok {
+			t.Errorf("rule %q has no flag; register one on Analyzer.Flags and add it here", rule.Category)
+			continue
+		}
+		if name != "" {
+			expected[name] = true
+		}
+	}
+
+	for name := range expected {
+		if goroutinectx.Analyzer.Flags.Lookup(name) == nil {
+			t.Errorf("flag -%s is not registered on Analyzer.Flags", name)
+		}
+	}
+
+	goroutinectx.Analyzer.Flags.VisitAll(func(f *flag.Flag) {
+		if !expected[f.Name] {
+			t.Errorf("flag -%s is not covered by a rule; add it to this test", f.Name)
+		}
+	})
+}
+
+func TestLoopBackground(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-loop-background", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-loop-background", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "loopbackground")
+}
+
+func TestCtxInDTO(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-in-dto", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-in-dto", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxindto")
+}
+
+func TestExplainMissingDeriver(t *testing.T) {
+	testdata := analysistest.TestData()
+	// Mixed: (Transaction.NewGoroutine AND NewContext) OR apm.NewGoroutineContext
+	deriveFunc := "github.com/newrelic/go-agent/v3/newrelic.Transaction.NewGoroutine+" +
+		"github.com/newrelic/go-agent/v3/newrelic.NewContext," +
+		"github.com/my-example-app/telemetry/apm.NewGoroutineContext"
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", deriveFunc); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("explain-missing-deriver", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+		_ = goroutinectx.Analyzer.Flags.Set("explain-missing-deriver", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "derivemissing")
+}
+
+func TestCtxValueAssert(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-value-assert", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-value-assert", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxvalueassert")
+}
+
+func TestHandlerMap(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctxless-handler-map", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctxless-handler-map", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "handlermap")
+}
+
+func TestCtxParamField(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-param-field-conflict", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-param-field-conflict", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxparamfield")
+}
+
+func TestTrackStructCtxFields(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("track-struct-ctx-fields", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("track-struct-ctx-fields", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "structctxfields")
+}
+
+func TestStrict(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "strict")
+}
+
+func TestStrictDerive(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	deriveFunc := "github.com/my-example-app/telemetry/apm.NewGoroutineContext"
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", deriveFunc); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "strictderive")
+}
+
+func TestCtxInCache(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-in-cache", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-in-cache", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxincache")
+}
+
+func TestCtxInCacheCustom(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-in-cache", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := goroutinectx.Analyzer.Flags.Set("cache-set-funcs", "ctxincachecustom.Cache.Set"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-in-cache", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("cache-set-funcs", "sync.Map.Store,sync.Map.LoadOrStore,sync.Map.Swap,"+
+			"github.com/patrickmn/go-cache.Cache.Set,github.com/patrickmn/go-cache.Cache.SetDefault,"+
+			"github.com/patrickmn/go-cache.Cache.Add")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxincachecustom")
+}
+
+func TestClosureStore(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("closure-store-funcs", "closurestore.Registry.Add,closurestore.OnShutdown"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("closure-store-funcs", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "closurestore")
+}
+
+func TestInitGoroutine(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-init-goroutines", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-init-goroutines", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "initgoroutine")
+}
+
+func TestGotaskDeriverFirst(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	deriveFunc := "github.com/my-example-app/telemetry/apm.NewGoroutineContext"
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", deriveFunc); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("gotask-deriver-first", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+		_ = goroutinectx.Analyzer.Flags.Set("gotask-deriver-first", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "gotaskfirst")
+}
+
+func TestExportedOnly(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("exported-only", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("exported-only", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "exportedonly")
+}
+
+func TestTrackLocalContexts(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("track-local-contexts", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("track-local-contexts", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "localctx")
+}
+
+func TestMinConfidence(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-channel-send", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-channel-send", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("min-confidence", "low")
+	}()
+
+	for _, level := range []string{"low", "medium", "high"} {
+		t.Run(level, func(t *testing.T) {
+			if err := goroutinectx.Analyzer.Flags.Set("min-confidence", level); err != nil {
+				t.Fatal(err)
+			}
+			analysistest.Run(t, testdata, goroutinectx.Analyzer, "minconfidence/"+level)
+		})
+	}
+}
+
+func TestConfigWarning(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "github.com/my-example-app/telemetry/apm.NewGoroutineCtx"); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("context-carriers", "github.com/my-example-app/telemetry/apm.Carrier"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("goroutine-deriver", "")
+		_ = goroutinectx.Analyzer.Flags.Set("context-carriers", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "configwarning")
+}
+
+func TestHTTPHandler(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("slog-struct-ctx", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("slog-struct-ctx", "false")
+	}()
+
+	analysistest.RunWithSuggestedFixes(t, testdata, goroutinectx.Analyzer, "httphandler")
+}
+
+func TestEscapingGoroutine(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-escaping-goroutine", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-escaping-goroutine", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "escapinggoroutine")
+}
+
+func TestBackoff(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("backoff", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("backoff", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "backoff")
+}
+
+func TestSyncOnce(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("sync-once", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("sync-once", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "synconce")
+}
+
+func TestFireAndForgetFuncs(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("fire-and-forget-funcs", "fireandforget.reportError,fireandforget.Metrics.Inc"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("fire-and-forget-funcs", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "fireandforget")
+}
+
+func TestCtxMapKey(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-map-key", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-map-key", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxmapkey")
+}
+
+func TestCtxInConstructor(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-stored-in-constructor", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-stored-in-constructor", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxinconstructor")
+}
+
+func TestStdLog(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-stdlog-in-goroutine", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-stdlog-in-goroutine", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "stdlog")
+}
+
+func TestStdLogPreferredLogger(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-stdlog-in-goroutine", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := goroutinectx.Analyzer.Flags.Set("preferred-logger", "log/slog.InfoContext"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-stdlog-in-goroutine", "false")
+		_ = goroutinectx.Analyzer.Flags.Set("preferred-logger", "")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "stdlogpreferred")
+}
+
+func TestRecognizeContextAccessors(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("recognize-context-accessors", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("recognize-context-accessors", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "contextaccessor")
+}
+
+func TestUnguardedChanOp(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-unguarded-channel-op", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-unguarded-channel-op", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "unguardedchanop")
+}
+
+func TestLibSpecs(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	bundle := filepath.Join(testdata, "src", "libspec", "ctxrelay-libs.yaml")
+	if err := goroutinectx.Analyzer.Flags.Set("lib-specs", bundle); err != nil {
+		t.Fatal(err)
+	}
+	if err := goroutinectx.Analyzer.Flags.Set("flag-blocking-io", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("lib-specs", "")
+		_ = goroutinectx.Analyzer.Flags.Set("flag-blocking-io", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "libspec")
+}
+
+func TestCtxFreeVarStore(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-ctx-freevar-store", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-ctx-freevar-store", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "ctxfreevarstore")
+}
+
+func TestTransitive(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("transitive", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("transitive", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "transitive")
+}
+
+func TestLockedGoroutine(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("flag-goroutine-under-lock", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("flag-goroutine-under-lock", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "lockedgoroutine")
+}
+
+func TestVerboseMessages(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := goroutinectx.Analyzer.Flags.Set("verbose-messages", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = goroutinectx.Analyzer.Flags.Set("verbose-messages", "false")
+	}()
+
+	analysistest.Run(t, testdata, goroutinectx.Analyzer, "verbosemessages")
+}
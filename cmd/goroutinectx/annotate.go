@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ignoreDirective is the prefix of the directives written by -annotate.
+const ignoreDirective = "//goroutinectx:ignore"
+
+// unusedIgnoreCategory is the category of unused ignore directives,
+// which cannot themselves be suppressed.
+const unusedIgnoreCategory = "ignore"
+
+// configCategory is the category of configuration diagnostics, which are
+// fixed in the flags rather than suppressed.
+const configCategory = "config"
+
+// annotate writes a //goroutinectx:ignore directive above every line with
+// a diagnostic, so that a re-run reports nothing.
+// It returns the process exit code.
+func annotate(diags []diagnostic, stderr io.Writer) int {
+	// filename -> line -> categories
+	byFile := make(map[string]map[int][]string)
+	for _, d := range diags {
+		if d.Category == unusedIgnoreCategory || d.Category == configCategory || d.Posn.Filename == "" {
+			continue
+		}
+		lines := byFile[d.Posn.Filename]
+		if lines == nil {
+			lines = make(map[int][]string)
+			byFile[d.Posn.Filename] = lines
+		}
+		if !slices.Contains(lines[d.Posn.Line], d.Category) {
+			lines[d.Posn.Line] = append(lines[d.Posn.Line], d.Category)
+		}
+	}
+
+	filenames := make([]string, 0, len(byFile))
+	for filename := range byFile {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		n, err := annotateFile(filename, byFile[filename])
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "goroutinectx: %v\n", err)
+			return exitError
+		}
+		_, _ = fmt.Fprintf(stderr, "%s: annotated %d line(s)\n", filename, n)
+	}
+	return exitOK
+}
+
+// annotateFile rewrites a single file and returns the number of annotated lines.
+func annotateFile(filename string, categories map[int][]string) (int, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return 0, err
+	}
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	lines := strings.SplitAfter(string(src), "\n")
+	out := make([]string, 0, len(lines)+len(categories))
+	n := 0
+
+	for i, line := range lines {
+		names := categories[i+1]
+		if len(names) == 0 {
+			out = append(out, line)
+			continue
+		}
+		sort.Strings(names)
+		n++
+
+		// Extend a directive on the previous line instead of stacking another one
+		if last := len(out) - 1; last >= 0 && isDirectiveLine(out[last]) {
+			out[last] = mergeDirective(out[last], names)
+			out = append(out, line)
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		out = append(out, indent+ignoreDirective+" "+strings.Join(names, ",")+"\n", line)
+	}
+
+	if err := os.WriteFile(filename, []byte(strings.Join(out, "")), info.Mode().Perm()); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// isDirectiveLine reports whether the line consists of an ignore directive only.
+func isDirectiveLine(line string) bool {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(line), ignoreDirective)
+	return ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t')
+}
+
+// mergeDirective adds names to the checker list of a directive line,
+// keeping any trailing explanation such as " - reason".
+func mergeDirective(line string, names []string) string {
+	idx := strings.Index(line, ignoreDirective) + len(ignoreDirective)
+	head, rest := line[:idx], strings.TrimRight(line[idx:], "\r\n")
+	eol := line[len(head)+len(rest):]
+
+	list, comment := strings.TrimLeft(rest, " \t"), ""
+	for _, marker := range []string{" - ", " //"} {
+		if i := strings.Index(list, marker); i >= 0 {
+			list, comment = list[:i], list[i:]+comment
+		}
+	}
+	if strings.HasPrefix(list, "-") {
+		list, comment = "", " "+list+comment
+	}
+
+	checkers := strings.Split(list, ",")
+	if strings.TrimSpace(list) == "" {
+		checkers = nil
+	}
+	for _, name := range names {
+		if !slices.Contains(checkers, name) {
+			checkers = append(checkers, name)
+		}
+	}
+
+	return head + " " + strings.Join(checkers, ",") + comment + eol
+}
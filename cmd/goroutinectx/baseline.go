@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// baselineEntry is a diagnostic recorded by -write-baseline.
+// Entries are matched by file, category, message and the trimmed source
+// line instead of the line number, so that they survive code moving up
+// or down the file.
+type baselineEntry struct {
+	File     string `json:"file"` // Slash-separated, relative to the baseline file
+	Category string `json:"category"`
+	Message  string `json:"message"`
+	Source   string `json:"source"` // Reported source line with surrounding whitespace trimmed
+}
+
+// baselineKeys builds the baseline entry of every diagnostic.
+// Files are made relative to the directory of the baseline file at path.
+func baselineKeys(path string, diags []diagnostic) ([]baselineEntry, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(abs)
+
+	// filename -> lines
+	sources := make(map[string][]string)
+	entries := make([]baselineEntry, len(diags))
+	for i, d := range diags {
+		lines, ok := sources[d.Posn.Filename]
+		if !ok {
+			if src, err := os.ReadFile(d.Posn.Filename); err == nil {
+				lines = strings.Split(string(src), "\n")
+			}
+			sources[d.Posn.Filename] = lines
+		}
+
+		file := d.Posn.Filename
+		if rel, err := filepath.Rel(dir, file); err == nil {
+			file = rel
+		}
+
+		var source string
+		if d.Posn.Line >= 1 && d.Posn.Line <= len(lines) {
+			source = strings.TrimSpace(lines[d.Posn.Line-1])
+		}
+
+		entries[i] = baselineEntry{
+			File:     filepath.ToSlash(file),
+			Category: d.Category,
+			Message:  d.Message,
+			Source:   source,
+		}
+	}
+	return entries, nil
+}
+
+// writeBaseline records diags in the baseline file at path.
+func writeBaseline(path string, diags []diagnostic) error {
+	entries, err := baselineKeys(path, diags)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].File < entries[j].File
+	})
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// loadBaseline reads the baseline file at path.
+func loadBaseline(path string) ([]baselineEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []baselineEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// filterBaseline drops the diagnostics recorded in the baseline file at
+// path. Each entry suppresses one diagnostic, so a new occurrence of an
+// identical line is still reported. It also returns the number of stale
+// entries that no longer match any diagnostic.
+func filterBaseline(path string, entries []baselineEntry, diags []diagnostic) ([]diagnostic, int, error) {
+	keys, err := baselineKeys(path, diags)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	remaining := make(map[baselineEntry]int)
+	for _, e := range entries {
+		remaining[e]++
+	}
+
+	var kept []diagnostic
+	for i, d := range diags {
+		if remaining[keys[i]] > 0 {
+			remaining[keys[i]]--
+			continue
+		}
+		kept = append(kept, d)
+	}
+
+	stale := 0
+	for _, n := range remaining {
+		stale += n
+	}
+	return kept, stale, nil
+}
@@ -0,0 +1,403 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/token"
+	"go/types"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/mpyw/goroutinectx"
+	"github.com/mpyw/goroutinectx/internal/configcheck"
+	"github.com/mpyw/goroutinectx/internal/deriver"
+	"github.com/mpyw/goroutinectx/internal/directive/carrier"
+	"github.com/mpyw/goroutinectx/internal/libspec"
+)
+
+// Exit codes follow the singlechecker conventions.
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitDiagnostics = 3
+)
+
+// usesSinglechecker reports whether args require the standard singlechecker
+// driver: they name the config file passed by go vet, or contain a flag the
+// custom driver does not register (e.g., -fix, -json or -V).
+// Otherwise the custom driver is used, so that all packages are analyzed
+// together.
+func usesSinglechecker(args []string) bool {
+	if len(args) == 1 && strings.HasSuffix(args[0], ".cfg") {
+		return true
+	}
+	fs := newFlagSet(&driverOptions{failOn: make(thresholds)}, io.Discard)
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		name := strings.TrimLeft(arg, "-")
+		if name == arg {
+			continue
+		}
+		if i := strings.IndexByte(name, '='); i >= 0 {
+			name = name[:i]
+		}
+		if name == "h" || name == "help" {
+			continue
+		}
+		if fs.Lookup(name) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// driverOptions holds the flags understood only by the custom driver.
+type driverOptions struct {
+	summary       bool
+	score         bool
+	failOn        thresholds
+	tests         bool
+	listRules     bool
+	annotate      bool
+	baseline      string
+	writeBaseline bool
+}
+
+// diagnostic is a reported diagnostic resolved to its source position.
+type diagnostic struct {
+	Posn     token.Position
+	Category string
+	Message  string
+}
+
+// runDriver analyzes the packages matched by the patterns in args and
+// returns the process exit code.
+// With -list-rules, it prints the rule metadata to stdout instead.
+// With -annotate, it rewrites the sources to suppress the diagnostics.
+// With -baseline, diagnostics recorded in the baseline file are dropped;
+// -write-baseline records the current ones there instead.
+func runDriver(args []string, stdout, stderr io.Writer) int {
+	opts := driverOptions{failOn: make(thresholds)}
+	fs := newFlagSet(&opts, stderr)
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if opts.listRules {
+		return listRules(stdout, stderr)
+	}
+
+	if fs.NArg() == 0 {
+		_, _ = fmt.Fprintln(stderr, "usage: goroutinectx [flags] packages...")
+		return exitUsage
+	}
+
+	if opts.writeBaseline && opts.baseline == "" {
+		_, _ = fmt.Fprintln(stderr, "goroutinectx: -write-baseline requires -baseline")
+		return exitUsage
+	}
+
+	diags, lines, err := analyze(fs.Args(), opts.tests, stderr)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "goroutinectx: %v\n", err)
+		return exitError
+	}
+
+	if opts.writeBaseline {
+		if err := writeBaseline(opts.baseline, diags); err != nil {
+			_, _ = fmt.Fprintf(stderr, "goroutinectx: %v\n", err)
+			return exitError
+		}
+		_, _ = fmt.Fprintf(stderr, "%s: recorded %d diagnostic(s)\n", opts.baseline, len(diags))
+		return exitOK
+	}
+
+	if opts.baseline != "" {
+		entries, err := loadBaseline(opts.baseline)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "goroutinectx: %v\n", err)
+			return exitError
+		}
+		var stale int
+		diags, stale, err = filterBaseline(opts.baseline, entries, diags)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "goroutinectx: %v\n", err)
+			return exitError
+		}
+		if stale > 0 {
+			_, _ = fmt.Fprintf(stderr, "goroutinectx: warning: %s: %d stale baseline entries; re-run with -write-baseline to drop them\n", opts.baseline, stale)
+		}
+	}
+
+	if opts.annotate {
+		return annotate(diags, stderr)
+	}
+
+	for _, d := range diags {
+		_, _ = fmt.Fprintf(stderr, "%s: %s\n", d.Posn, d.Message)
+	}
+
+	counts := countByCategory(diags)
+	if opts.summary {
+		_, _ = fmt.Fprintln(stderr, formatSummary(counts, opts.failOn))
+	}
+	if opts.score {
+		if err := json.NewEncoder(stderr).Encode(newScore(counts, len(diags), lines)); err != nil {
+			_, _ = fmt.Fprintf(stderr, "goroutinectx: %v\n", err)
+			return exitError
+		}
+	}
+
+	if opts.failOn.exceeded(counts, len(diags)) {
+		return exitDiagnostics
+	}
+	return exitOK
+}
+
+// newFlagSet returns the flag set of the custom driver: the analyzer flags
+// plus the driver options, which are stored into opts.
+func newFlagSet(opts *driverOptions, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("goroutinectx", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	goroutinectx.Analyzer.Flags.VisitAll(func(f *flag.Flag) {
+		fs.Var(f.Value, f.Name, f.Usage)
+	})
+
+	fs.BoolVar(&opts.summary, "summary", false, "print per-category diagnostic counts to stderr")
+	fs.BoolVar(&opts.score, "score", false, "print diagnostics per 1000 lines analyzed and the raw counts to stderr as JSON")
+	fs.Var(opts.failOn, "fail-on", "comma-separated category:max thresholds that fail the run when exceeded (e.g., goroutine:0,errgroup:5)")
+	fs.BoolVar(&opts.tests, "test", true, "indicates whether test files should be analyzed, too")
+	fs.BoolVar(&opts.listRules, "list-rules", false, "print every rule as a JSON array to stdout and exit")
+	fs.BoolVar(&opts.annotate, "annotate", false, "add //goroutinectx:ignore directives above every line with a diagnostic instead of reporting it")
+	fs.StringVar(&opts.baseline, "baseline", "", "JSON file of known diagnostics to suppress, so that only new ones are reported")
+	fs.BoolVar(&opts.writeBaseline, "write-baseline", false, "record every diagnostic in the -baseline file instead of reporting it")
+	return fs
+}
+
+// listRules writes the rule metadata as an indented JSON array.
+func listRules(stdout, stderr io.Writer) int {
+	enc := json.NewEncoder(stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(goroutinectx.Rules()); err != nil {
+		_, _ = fmt.Fprintf(stderr, "goroutinectx: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
+
+// analyze loads the packages and runs the analyzer, returning diagnostics
+// sorted by position with duplicates (e.g., from test variants) removed,
+// along with the number of source lines analyzed.
+// Configured derivers and carriers whose package is never imported are
+// reported to stderr.
+func analyze(patterns []string, tests bool, stderr io.Writer) ([]diagnostic, int, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles |
+			packages.NeedImports | packages.NeedDeps | packages.NeedTypes |
+			packages.NeedTypesSizes | packages.NeedSyntax | packages.NeedTypesInfo |
+			packages.NeedModule,
+		Tests: tests,
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, 0, err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, 0, errors.New("errors while loading packages")
+	}
+
+	typesPkgs := make([]*types.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		typesPkgs = append(typesPkgs, pkg.Types)
+	}
+	specs, err := configuredSpecs()
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, warning := range configcheck.Unimported(specs, typesPkgs) {
+		_, _ = fmt.Fprintf(stderr, "goroutinectx: warning: %s\n", warning)
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{goroutinectx.Analyzer}, pkgs, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	seen := make(map[string]bool)
+	seenFiles := make(map[string]bool)
+	var diags []diagnostic
+	lines := 0
+
+	for act := range graph.All() {
+		if !act.IsRoot {
+			continue
+		}
+		if act.Err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", act.Package.PkgPath, act.Err)
+		}
+		for _, f := range act.Package.Syntax {
+			tf := act.Package.Fset.File(f.Pos())
+			if tf == nil || seenFiles[tf.Name()] {
+				continue
+			}
+			seenFiles[tf.Name()] = true
+			lines += tf.LineCount()
+		}
+		for _, d := range act.Diagnostics {
+			posn := act.Package.Fset.Position(d.Pos)
+			key := posn.String() + "\x00" + d.Message
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			diags = append(diags, diagnostic{Posn: posn, Category: d.Category, Message: d.Message})
+		}
+	}
+
+	sort.Slice(diags, func(i, j int) bool {
+		a, b := diags[i].Posn, diags[j].Posn
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+
+	return diags, lines, nil
+}
+
+// configuredSpecs returns the derivers and carriers configured by the
+// analyzer flags, extended by the -lib-specs bundle.
+func configuredSpecs() ([]configcheck.Spec, error) {
+	flagValue := func(name string) string {
+		return goroutinectx.Analyzer.Flags.Lookup(name).Value.String()
+	}
+
+	var libs *libspec.Bundle
+	if path := flagValue("lib-specs"); path != "" {
+		var err error
+		if libs, err = libspec.Load(path); err != nil {
+			return nil, err
+		}
+	}
+
+	derivers := deriver.NewMatcher(deriver.WithPackages(libs.Join(libspec.Derivers, flagValue("goroutine-deriver")), flagValue("deriver-packages")))
+	carriers := carrier.Parse(libs.Join(libspec.Carriers, flagValue("context-carriers")))
+	return append(configcheck.Derivers(derivers), configcheck.Carriers(carriers)...), nil
+}
+
+// countByCategory counts diagnostics per category.
+func countByCategory(diags []diagnostic) map[string]int {
+	counts := make(map[string]int)
+	for _, d := range diags {
+		counts[d.Category]++
+	}
+	return counts
+}
+
+// score is the -score output.
+type score struct {
+	Score       float64        `json:"score"` // Diagnostics per 1000 lines
+	Diagnostics int            `json:"diagnostics"`
+	Lines       int            `json:"lines"`
+	Categories  map[string]int `json:"categories"`
+}
+
+// newScore computes the number of diagnostics per 1000 analyzed lines.
+func newScore(counts map[string]int, total, lines int) score {
+	s := score{Diagnostics: total, Lines: lines, Categories: counts}
+	if lines > 0 {
+		s.Score = float64(total) * 1000 / float64(lines)
+	}
+	return s
+}
+
+// formatSummary formats counts as "category: N" pairs sorted by category.
+// Categories with a threshold are listed even when they have no diagnostics.
+func formatSummary(counts map[string]int, failOn thresholds) string {
+	categories := make([]string, 0, len(counts)+len(failOn))
+	for category := range counts {
+		categories = append(categories, category)
+	}
+	for category := range failOn {
+		if _, ok := counts[category]; !ok {
+			categories = append(categories, category)
+		}
+	}
+	sort.Strings(categories)
+
+	parts := make([]string, len(categories))
+	for i, category := range categories {
+		parts[i] = fmt.Sprintf("%s: %d", category, counts[category])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// thresholds maps a diagnostic category to the maximum number of
+// diagnostics tolerated before the run fails.
+type thresholds map[string]int
+
+// String implements flag.Value.
+func (t thresholds) String() string {
+	parts := make([]string, 0, len(t))
+	for category, limit := range t {
+		parts = append(parts, category+":"+strconv.Itoa(limit))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// Set implements flag.Value.
+// Format: "category:max,category:max".
+func (t thresholds) Set(s string) error {
+	for part := range strings.SplitSeq(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		category, limitStr, ok := strings.Cut(part, ":")
+		if !ok {
+			return fmt.Errorf("invalid threshold %q: expected category:max", part)
+		}
+
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 0 {
+			return fmt.Errorf("invalid threshold %q: max must be a non-negative integer", part)
+		}
+
+		t[strings.TrimSpace(category)] = limit
+	}
+	return nil
+}
+
+// exceeded reports whether the run should fail.
+// Without thresholds any diagnostic fails the run; with thresholds only the
+// listed categories are gated, so other categories can be adopted gradually.
+func (t thresholds) exceeded(counts map[string]int, total int) bool {
+	if len(t) == 0 {
+		return total > 0
+	}
+	for category, limit := range t {
+		if counts[category] > limit {
+			return true
+		}
+	}
+	return false
+}
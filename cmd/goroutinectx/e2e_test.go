@@ -1,6 +1,7 @@
 package main_test
 
 import (
+	"encoding/json"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -49,6 +50,24 @@ func getE2ETestdata() string {
 	return filepath.Join(getModuleRoot(), "cmd", "goroutinectx", "testdata")
 }
 
+// copyE2ETestdata copies files of a fixture to a temporary directory,
+// for tests that modify the sources.
+func copyE2ETestdata(t *testing.T, fixture string, names ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	src := filepath.Join(getE2ETestdata(), fixture)
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(src, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
 func TestE2E_BasicGoroutine(t *testing.T) {
 	testdata := filepath.Join(getE2ETestdata(), "basic")
 
@@ -217,6 +236,20 @@ func TestE2E_Version(t *testing.T) {
 	}
 }
 
+func TestE2E_SinglecheckerFallback(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "basic")
+
+	// -json is not a driver flag, so singlechecker prints the diagnostics as JSON
+	cmd := exec.Command(binaryPath, "-json", "./...")
+	cmd.Dir = testdata
+	out, _ := cmd.CombinedOutput()
+
+	var result map[string]any
+	if err := json.Unmarshal(out, &result); err != nil {
+		t.Errorf("expected JSON output from singlechecker, got:\n%s", out)
+	}
+}
+
 func TestE2E_Spawner(t *testing.T) {
 	testdata := filepath.Join(getE2ETestdata(), "spawner")
 
@@ -278,6 +311,74 @@ func TestE2E_GoroutineDerive(t *testing.T) {
 	}
 }
 
+func TestE2E_UndefinedDeriverWarning(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "goroutinederive")
+
+	cmd := exec.Command(binaryPath,
+		"-goroutine-deriver=example.com/goroutinederive/apm.NewGoroutineCtx",
+		"-context-carriers=example.com/goroutinederive/apm.Carrier",
+		"./...",
+	)
+	cmd.Dir = testdata
+	out, _ := cmd.CombinedOutput()
+
+	output := string(out)
+
+	// Reported at the import in main.go, and at the package clause of apm itself
+	for _, want := range []string{
+		"main.go:7:2: -goroutine-deriver example.com/goroutinederive/apm.NewGoroutineCtx: not declared in package example.com/goroutinederive/apm",
+		"main.go:7:2: -context-carriers example.com/goroutinederive/apm.Carrier: not declared in package example.com/goroutinederive/apm",
+		"apm.go:1:1: -goroutine-deriver example.com/goroutinederive/apm.NewGoroutineCtx: not declared in package example.com/goroutinederive/apm",
+		"apm.go:1:1: -context-carriers example.com/goroutinederive/apm.Carrier: not declared in package example.com/goroutinederive/apm",
+	} {
+		if strings.Count(output, want) != 1 {
+			t.Errorf("expected diagnostic %q once, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestE2E_UnimportedDeriverWarning(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "goroutinederive")
+
+	for name, flags := range map[string][]string{
+		"default": nil,
+		"summary": {"-summary"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			args := append(flags, "-goroutine-deriver=example.com/missing/apm.NewGoroutineContext", "./...")
+			cmd := exec.Command(binaryPath, args...)
+			cmd.Dir = testdata
+			out, _ := cmd.CombinedOutput()
+
+			output := string(out)
+
+			want := "-goroutine-deriver example.com/missing/apm.NewGoroutineContext: package not imported by any analyzed package"
+			if !strings.Contains(output, want) {
+				t.Errorf("expected warning %q, got:\n%s", want, output)
+			}
+		})
+	}
+}
+
+func TestE2E_UnimportedLibSpecWarning(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "goroutinederive")
+
+	cmd := exec.Command(binaryPath, "-lib-specs=ctxrelay-libs.yaml", "./...")
+	cmd.Dir = testdata
+	out, _ := cmd.CombinedOutput()
+
+	output := string(out)
+
+	for _, want := range []string{
+		"-goroutine-deriver example.com/missing/apm.NewGoroutineContext: package not imported by any analyzed package",
+		"-context-carriers example.com/missing/job.Job: package not imported by any analyzed package",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected warning %q, got:\n%s", want, output)
+		}
+	}
+}
+
 func TestE2E_DisableSpawnerChecker(t *testing.T) {
 	testdata := filepath.Join(getE2ETestdata(), "spawner")
 
@@ -291,3 +392,270 @@ func TestE2E_DisableSpawnerChecker(t *testing.T) {
 		t.Errorf("expected zero exit code when spawner checker disabled, got error: %v\noutput:\n%s", err, out)
 	}
 }
+
+func TestE2E_SummaryWithinThreshold(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "basic")
+
+	cmd := exec.Command(binaryPath, "-summary", "-fail-on=goroutine:2", "./...")
+	cmd.Dir = testdata
+	out, err := cmd.CombinedOutput()
+
+	// Should exit with zero (count does not exceed threshold)
+	if err != nil {
+		t.Errorf("expected zero exit code within threshold, got error: %v\noutput:\n%s", err, out)
+	}
+
+	if !strings.Contains(string(out), "goroutine: 2") {
+		t.Errorf("expected per-category summary, got:\n%s", out)
+	}
+}
+
+func TestE2E_SummaryExceedsThreshold(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "basic")
+
+	cmd := exec.Command(binaryPath, "-fail-on=goroutine:1", "./...")
+	cmd.Dir = testdata
+	out, err := cmd.CombinedOutput()
+
+	// Should exit with non-zero (count exceeds threshold)
+	if err == nil {
+		t.Fatalf("expected non-zero exit code when threshold exceeded, output:\n%s", out)
+	}
+
+	if !strings.Contains(string(out), `goroutine does not propagate context "ctx"`) {
+		t.Errorf("expected diagnostics to be printed, got:\n%s", out)
+	}
+}
+
+func TestE2E_SummaryUngatedCategory(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "basic")
+
+	// Only errgroup is gated, so goroutine diagnostics don't fail the run
+	cmd := exec.Command(binaryPath, "-fail-on=errgroup:0", "./...")
+	cmd.Dir = testdata
+	out, err := cmd.CombinedOutput()
+
+	if err != nil {
+		t.Errorf("expected zero exit code for ungated category, got error: %v\noutput:\n%s", err, out)
+	}
+}
+
+func TestE2E_SummaryInvalidThreshold(t *testing.T) {
+	cmd := exec.Command(binaryPath, "-fail-on=goroutine", "./...")
+	cmd.Dir = getE2ETestdata()
+	_, err := cmd.CombinedOutput()
+
+	if err == nil {
+		t.Error("expected non-zero exit code for invalid threshold")
+	}
+}
+
+func TestE2E_ListRules(t *testing.T) {
+	cmd := exec.Command(binaryPath, "-list-rules")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("expected zero exit code, got error: %v", err)
+	}
+
+	var rules []struct {
+		Category     string `json:"category"`
+		Severity     string `json:"severity"`
+		Description  string `json:"description"`
+		SuggestedFix bool   `json:"suggestedFix"`
+	}
+	if err := json.Unmarshal(out, &rules); err != nil {
+		t.Fatalf("invalid JSON: %v\noutput:\n%s", err, out)
+	}
+
+	byCategory := make(map[string]bool)
+	for _, r := range rules {
+		if r.Severity == "" || r.Description == "" {
+			t.Errorf("rule %q has empty severity or description", r.Category)
+		}
+		byCategory[r.Category] = r.SuggestedFix
+	}
+
+	for _, category := range []string{"goroutine", "goroutinederive", "waitgroup", "errgroup", "spawner", "gotask", "ignore"} {
+		if _, ok := byCategory[category]; !ok {
+			t.Errorf("expected category %q in output:\n%s", category, out)
+		}
+	}
+	if !byCategory["signal"] {
+		t.Errorf("expected signal rule to support suggested fixes")
+	}
+}
+
+func TestE2E_Score(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "basic")
+
+	cmd := exec.Command(binaryPath, "-score", "-fail-on=goroutine:10", "./...")
+	cmd.Dir = testdata
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("expected zero exit code within threshold, got error: %v\noutput:\n%s", err, stderr.String())
+	}
+
+	// The JSON line follows the diagnostics
+	output := strings.TrimSpace(stderr.String())
+	line := output[strings.LastIndexByte(output, '\n')+1:]
+
+	var got struct {
+		Score       float64        `json:"score"`
+		Diagnostics int            `json:"diagnostics"`
+		Lines       int            `json:"lines"`
+		Categories  map[string]int `json:"categories"`
+	}
+	if err := json.Unmarshal([]byte(line), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", line, err)
+	}
+
+	src, err := os.ReadFile(filepath.Join(testdata, "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLines := strings.Count(string(src), "\n")
+	wantDiags := strings.Count(output, `goroutine does not propagate context "ctx"`)
+	if wantDiags == 0 {
+		t.Fatalf("expected diagnostics in fixture, got:\n%s", output)
+	}
+
+	if got.Lines != wantLines {
+		t.Errorf("lines = %d, want %d", got.Lines, wantLines)
+	}
+	if got.Diagnostics != wantDiags || got.Categories["goroutine"] != wantDiags {
+		t.Errorf("diagnostics = %d (goroutine: %d), want %d", got.Diagnostics, got.Categories["goroutine"], wantDiags)
+	}
+	if want := float64(wantDiags) * 1000 / float64(wantLines); got.Score != want {
+		t.Errorf("score = %v, want %v", got.Score, want)
+	}
+}
+
+func TestE2E_Annotate(t *testing.T) {
+	// Annotate a copy so the fixture itself stays unchanged
+	testdata := copyE2ETestdata(t, "annotate", "go.mod", "go.sum", "main.go")
+
+	cmd := exec.Command(binaryPath, "-annotate", "./...")
+	cmd.Dir = testdata
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("expected zero exit code for -annotate, got error: %v\noutput:\n%s", err, out)
+	}
+
+	got, err := os.ReadFile(filepath.Join(testdata, "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"\t//goroutinectx:ignore goroutine\n\tgo func() {",
+		"\t//goroutinectx:ignore errgroup\n\tg.Go(func() error {",
+		"\t//goroutinectx:ignore errgroup,goroutine - legacy worker\n",
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("expected %q in annotated source:\n%s", want, got)
+		}
+	}
+	if n := strings.Count(string(got), "//goroutinectx:ignore goroutine\n"); n != 2 {
+		t.Errorf("expected existing directive not to be duplicated, got %d goroutine directives:\n%s", n, got)
+	}
+
+	// Re-running against the baseline should report nothing
+	cmd = exec.Command(binaryPath, "./...")
+	cmd.Dir = testdata
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("expected zero exit code after -annotate, got error: %v\noutput:\n%s", err, out)
+	}
+}
+
+func TestE2E_Baseline(t *testing.T) {
+	testdata := copyE2ETestdata(t, "basic", "go.mod", "main.go")
+
+	cmd := exec.Command(binaryPath, "-baseline=baseline.json", "-write-baseline", "./...")
+	cmd.Dir = testdata
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("expected zero exit code for -write-baseline, got error: %v\noutput:\n%s", err, out)
+	}
+
+	data, err := os.ReadFile(filepath.Join(testdata, "baseline.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []struct {
+		File     string `json:"file"`
+		Category string `json:"category"`
+		Source   string `json:"source"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatalf("failed to parse baseline: %v\n%s", err, data)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 baseline entries, got %d:\n%s", len(entries), data)
+	}
+	if entries[0].File != "main.go" || entries[0].Category != "goroutine" {
+		t.Errorf("expected entries relative to the baseline file, got %+v", entries[0])
+	}
+
+	// Known diagnostics are suppressed
+	cmd = exec.Command(binaryPath, "-baseline=baseline.json", "./...")
+	cmd.Dir = testdata
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("expected zero exit code with a baseline, got error: %v\noutput:\n%s", err, out)
+	}
+
+	// Shifting lines keeps them suppressed, while a new occurrence is reported
+	src, err := os.ReadFile(filepath.Join(testdata, "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	modified := strings.Replace(string(src), "func main() {", "// shifted\n\nfunc main() {", 1) + `
+func badNew(ctx context.Context) {
+	go func() {
+		fmt.Println("new")
+	}()
+}
+`
+	if err := os.WriteFile(filepath.Join(testdata, "main.go"), []byte(modified), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd = exec.Command(binaryPath, "-baseline=baseline.json", "./...")
+	cmd.Dir = testdata
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("expected non-zero exit code for a new diagnostic, output:\n%s", out)
+	}
+	if n := strings.Count(string(out), "goroutine does not propagate context"); n != 1 {
+		t.Errorf("expected only the new diagnostic, got %d:\n%s", n, out)
+	}
+
+	// Fixed diagnostics are reported as stale entries
+	fixed := strings.Replace(modified, "fmt.Println(\"work without ctx\")", "_ = ctx", 1)
+	fixed = strings.Replace(fixed, "fmt.Println(\"new\")", "_ = ctx", 1)
+	if err := os.WriteFile(filepath.Join(testdata, "main.go"), []byte(fixed), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd = exec.Command(binaryPath, "-baseline=baseline.json", "./...")
+	cmd.Dir = testdata
+	out, err = cmd.CombinedOutput()
+	if err != nil {
+		t.Errorf("expected zero exit code after fixing, got error: %v\noutput:\n%s", err, out)
+	}
+	if !strings.Contains(string(out), "1 stale baseline entries") {
+		t.Errorf("expected stale entry warning, got:\n%s", out)
+	}
+}
+
+func TestE2E_WriteBaselineRequiresBaseline(t *testing.T) {
+	testdata := filepath.Join(getE2ETestdata(), "basic")
+
+	cmd := exec.Command(binaryPath, "-write-baseline", "./...")
+	cmd.Dir = testdata
+	out, err := cmd.CombinedOutput()
+
+	if err == nil {
+		t.Fatalf("expected non-zero exit code without -baseline, output:\n%s", out)
+	}
+	if !strings.Contains(string(out), "-write-baseline requires -baseline") {
+		t.Errorf("expected usage error, got:\n%s", out)
+	}
+}
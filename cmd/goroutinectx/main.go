@@ -2,11 +2,18 @@
 package main
 
 import (
+	"os"
+
 	"golang.org/x/tools/go/analysis/singlechecker"
 
 	"github.com/mpyw/goroutinectx"
 )
 
 func main() {
-	singlechecker.Main(goroutinectx.Analyzer)
+	if usesSinglechecker(os.Args[1:]) {
+		singlechecker.Main(goroutinectx.Analyzer)
+		return
+	}
+
+	os.Exit(runDriver(os.Args[1:], os.Stdout, os.Stderr))
 }
@@ -0,0 +1,5 @@
+module example.com/annotate
+
+go 1.24.0
+
+require golang.org/x/sync v0.19.0
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+func main() {
+	ctx := context.Background()
+	badGoroutine(ctx)
+	badErrgroup(ctx)
+	alreadyIgnored(ctx)
+	partiallyIgnored(ctx)
+}
+
+// badGoroutine: annotated with a goroutine directive
+func badGoroutine(ctx context.Context) {
+	go func() {
+		fmt.Println("work")
+	}()
+}
+
+// badErrgroup: annotated with an errgroup directive
+func badErrgroup(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		fmt.Println("work")
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// alreadyIgnored: left untouched
+func alreadyIgnored(ctx context.Context) {
+	//goroutinectx:ignore goroutine
+	go func() {
+		fmt.Println("work")
+	}()
+}
+
+// partiallyIgnored: the missing checker is merged into the existing directive
+func partiallyIgnored(ctx context.Context) {
+	g := new(errgroup.Group)
+	//goroutinectx:ignore errgroup - legacy worker
+	g.Go(func() error { go func() { fmt.Println("work") }(); return nil })
+	_ = g.Wait()
+}
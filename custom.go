@@ -0,0 +1,179 @@
+package goroutinectx
+
+import (
+	"flag"
+	"go/ast"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/directive/spawner"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/ssa"
+)
+
+// CheckContext is passed to custom checkers.
+// It wraps the same per-scope state the built-in checkers receive.
+type CheckContext struct {
+	Pass     *analysis.Pass
+	CtxNames []string // Context variable names in scope
+
+	probe *probe.Context
+}
+
+// UsesContext reports whether the expression references a context variable
+// in scope, including through nested func literals and carrier types.
+func (c *CheckContext) UsesContext(expr ast.Expr) bool {
+	return c.probe.ArgUsesContext(expr)
+}
+
+// FuncLitUsesContext reports whether the func literal references a context
+// variable in scope, ignoring nested func literals.
+func (c *CheckContext) FuncLitUsesContext(lit *ast.FuncLit) bool {
+	return c.probe.FuncLitUsesContext(lit)
+}
+
+// CallChecker is a user-defined checker for call expressions.
+// Like the built-in checkers, it only runs inside functions with a context in scope.
+type CallChecker interface {
+	// Name returns the checker name used for diagnostics categories and ignore directives.
+	Name() string
+	// MatchCall returns true if this checker should handle the call.
+	MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool
+	// CheckCall returns a diagnostic message, or "" if the call is fine.
+	CheckCall(cctx *CheckContext, call *ast.CallExpr) string
+}
+
+// GoStmtChecker is a user-defined checker for go statements.
+// Like the built-in checkers, it only runs inside functions with a context in scope.
+type GoStmtChecker interface {
+	// Name returns the checker name used for diagnostics categories and ignore directives.
+	Name() string
+	// CheckGoStmt returns a diagnostic message, or "" if the statement is fine.
+	CheckGoStmt(cctx *CheckContext, stmt *ast.GoStmt) string
+}
+
+// Options configures an analyzer created by NewWithOptions.
+type Options struct {
+	CallCheckers   []CallChecker
+	GoStmtCheckers []GoStmtChecker
+}
+
+// Registered custom checkers, consulted by every analyzer in addition to the built-ins.
+var (
+	customMu             sync.Mutex
+	customCallCheckers   []CallChecker
+	customGoStmtCheckers []GoStmtChecker
+)
+
+// Register adds a custom call checker to Analyzer and all analyzers created by NewWithOptions.
+//
+// Register is safe for concurrent use, but it must be called before analysis
+// starts (typically from init or main); checkers registered while a pass is
+// running only take effect for packages analyzed afterwards.
+func Register(c CallChecker) {
+	customMu.Lock()
+	defer customMu.Unlock()
+	customCallCheckers = append(customCallCheckers, c)
+}
+
+// RegisterGoStmt adds a custom go statement checker.
+// The same concurrency rules as Register apply.
+func RegisterGoStmt(c GoStmtChecker) {
+	customMu.Lock()
+	defer customMu.Unlock()
+	customGoStmtCheckers = append(customGoStmtCheckers, c)
+}
+
+// resetCustomCheckers removes every registered checker.
+// It lets tests undo Register and RegisterGoStmt.
+func resetCustomCheckers() {
+	customMu.Lock()
+	defer customMu.Unlock()
+	customCallCheckers = nil
+	customGoStmtCheckers = nil
+}
+
+// NewWithOptions creates an analyzer that runs the extra checkers in opts
+// alongside the built-in and registered checkers.
+// The returned analyzer shares its flags with Analyzer.
+func NewWithOptions(opts Options) *analysis.Analyzer {
+	a := &analysis.Analyzer{
+		Name:     Analyzer.Name,
+		Doc:      Analyzer.Doc,
+		Requires: []*analysis.Analyzer{inspect.Analyzer, ssa.BuildSSAAnalyzer, spawner.Analyzer},
+		Run: func(pass *analysis.Pass) (any, error) {
+			return runWithOptions(pass, opts)
+		},
+	}
+	Analyzer.Flags.VisitAll(func(f *flag.Flag) {
+		a.Flags.Var(f.Value, f.Name, f.Usage)
+	})
+	return a
+}
+
+// customCheckers returns the registered checkers followed by those in opts.
+func customCheckers(opts Options) ([]internal.GoStmtChecker, []internal.CallChecker) {
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	goStmtCheckers := make([]internal.GoStmtChecker, 0, len(customGoStmtCheckers)+len(opts.GoStmtCheckers))
+	for _, c := range append(append([]GoStmtChecker(nil), customGoStmtCheckers...), opts.GoStmtCheckers...) {
+		goStmtCheckers = append(goStmtCheckers, customGoStmtChecker{c})
+	}
+
+	callCheckers := make([]internal.CallChecker, 0, len(customCallCheckers)+len(opts.CallCheckers))
+	for _, c := range append(append([]CallChecker(nil), customCallCheckers...), opts.CallCheckers...) {
+		callCheckers = append(callCheckers, customCallChecker{c})
+	}
+
+	return goStmtCheckers, callCheckers
+}
+
+// customCallChecker adapts a CallChecker to internal.CallChecker.
+type customCallChecker struct {
+	c CallChecker
+}
+
+func (a customCallChecker) Name() ignore.CheckerName {
+	return ignore.CheckerName(a.c.Name())
+}
+
+func (a customCallChecker) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	return a.c.MatchCall(pass, call)
+}
+
+func (a customCallChecker) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	return customResult(a.c.CheckCall(newCheckContext(cctx), call))
+}
+
+// customGoStmtChecker adapts a GoStmtChecker to internal.GoStmtChecker.
+type customGoStmtChecker struct {
+	c GoStmtChecker
+}
+
+func (a customGoStmtChecker) Name() ignore.CheckerName {
+	return ignore.CheckerName(a.c.Name())
+}
+
+func (a customGoStmtChecker) CheckGoStmt(cctx *probe.Context, stmt *ast.GoStmt) *internal.Result {
+	return customResult(a.c.CheckGoStmt(newCheckContext(cctx), stmt))
+}
+
+func newCheckContext(cctx *probe.Context) *CheckContext {
+	return &CheckContext{
+		Pass:     cctx.Pass,
+		CtxNames: cctx.CtxNames,
+		probe:    cctx,
+	}
+}
+
+func customResult(msg string) *internal.Result {
+	if msg == "" {
+		return internal.OK()
+	}
+	return internal.Fail(msg)
+}
@@ -0,0 +1,4 @@
+package goroutinectx
+
+// ResetCustomCheckers exposes resetCustomCheckers to the external test package.
+var ResetCustomCheckers = resetCustomCheckers
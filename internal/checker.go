@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
 
 	"golang.org/x/tools/go/analysis"
 
@@ -31,11 +33,70 @@ type CallChecker interface {
 	CheckCall(cctx *probe.Context, call *ast.CallExpr) *Result
 }
 
+// NodeChecker checks other node types (assignments, comparisons, ...).
+// GoStmt and CallExpr nodes are never passed to NodeCheckers.
+type NodeChecker interface {
+	Checker
+	// NodeTypes returns the node types this checker wants to receive.
+	NodeTypes() []ast.Node
+	// CheckNode checks the node. Failures are reported at the node's position.
+	CheckNode(cctx *probe.Context, node ast.Node) *Result
+}
+
+// Confidence is how certain a checker is that a failure is a real problem.
+// The zero value is ConfidenceHigh, so untagged failures are always reported.
+type Confidence int
+
+const (
+	// ConfidenceHigh is for drops proved directly from the AST.
+	ConfidenceHigh Confidence = iota
+	// ConfidenceMedium is for failures inferred by tracing SSA values.
+	ConfidenceMedium
+	// ConfidenceLow is for heuristic guesses.
+	ConfidenceLow
+)
+
+// String returns the flag value of the confidence level.
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceHigh:
+		return "high"
+	case ConfidenceMedium:
+		return "medium"
+	case ConfidenceLow:
+		return "low"
+	}
+	return fmt.Sprintf("Confidence(%d)", int(c))
+}
+
+// AtLeast reports whether c is at or above the threshold.
+func (c Confidence) AtLeast(threshold Confidence) bool {
+	return c <= threshold
+}
+
+// ParseConfidence parses a -min-confidence flag value.
+func ParseConfidence(s string) (Confidence, error) {
+	switch s {
+	case "high":
+		return ConfidenceHigh, nil
+	case "medium":
+		return ConfidenceMedium, nil
+	case "low":
+		return ConfidenceLow, nil
+	}
+	return 0, fmt.Errorf("invalid confidence %q: must be low, medium or high", s)
+}
+
 // Result represents the outcome of a check.
 type Result struct {
-	OK       bool   // Check passed
-	Message  string // Error message if not OK
-	DeferMsg string // Alternative message if only defer has the check
+	OK         bool                          // Check passed
+	Message    string                        // Error message if not OK
+	DeferMsg   string                        // Alternative message if only defer has the check
+	Fixes      []analysis.SuggestedFix       // Suggested fixes attached to the diagnostic
+	Confidence Confidence                    // How certain the failure is
+	Related    []analysis.RelatedInformation // Machine-readable detail attached to the diagnostic
+	Pos        token.Pos                     // Position to report at, overriding the checker's default
+	More       []*Result                     // Further failures of the same check, such as one per argument
 }
 
 // OK returns a passing result.
@@ -48,7 +109,57 @@ func Fail(msg string) *Result {
 	return &Result{OK: false, Message: msg}
 }
 
+// FailAt returns a failing result reported at pos.
+func FailAt(pos token.Pos, msg string) *Result {
+	return &Result{OK: false, Message: msg, Pos: pos}
+}
+
+// Join combines the results of a check that may fail several times, such
+// as once per argument. It returns a passing result if none of them failed.
+func Join(results ...*Result) *Result {
+	var failures []*Result
+	for _, r := range results {
+		if r != nil && !r.OK {
+			failures = append(failures, r)
+		}
+	}
+	if len(failures) == 0 {
+		return OK()
+	}
+	return &Result{OK: false, More: failures}
+}
+
+// Failures returns the failing result itself, if it has a message, followed
+// by the failures joined into it.
+func (r *Result) Failures() []*Result {
+	var failures []*Result
+	if !r.OK && r.Message != "" {
+		failures = append(failures, r)
+	}
+	for _, more := range r.More {
+		failures = append(failures, more.Failures()...)
+	}
+	return failures
+}
+
+// FailWithFix returns a failing result with message and suggested fixes.
+func FailWithFix(msg string, fixes ...analysis.SuggestedFix) *Result {
+	return &Result{OK: false, Message: msg, Fixes: fixes}
+}
+
 // FailWithDefer returns a failing result with defer-specific message.
 func FailWithDefer(msg, deferMsg string) *Result {
 	return &Result{OK: false, Message: msg, DeferMsg: deferMsg}
 }
+
+// WithConfidence tags a failing result with a confidence level.
+func (r *Result) WithConfidence(c Confidence) *Result {
+	r.Confidence = c
+	return r
+}
+
+// WithRelated attaches related information to a failing result.
+func (r *Result) WithRelated(related ...analysis.RelatedInformation) *Result {
+	r.Related = related
+	return r
+}
@@ -0,0 +1,73 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// backoffPolicyArgIdx is the index of the BackOff argument of the retry functions.
+const backoffPolicyArgIdx = 1
+
+var (
+	// backoffWithContext makes a backoff policy stop when ctx is done.
+	backoffWithContext = funcspec.Spec{PkgPath: "github.com/cenkalti/backoff", FuncName: "WithContext"}
+
+	// backoffRetryFuncs take an operation and a backoff policy.
+	backoffRetryFuncs = []funcspec.Spec{
+		{PkgPath: "github.com/cenkalti/backoff", FuncName: "Retry"},
+		{PkgPath: "github.com/cenkalti/backoff", FuncName: "RetryNotify"},
+	}
+)
+
+// Backoff reports cenkalti/backoff retries whose policy was not wrapped
+// with backoff.WithContext while a context is in scope:
+//
+//	b := backoff.NewExponentialBackOff()
+//	backoff.Retry(op, b)                          // reported
+//	backoff.Retry(op, backoff.WithContext(b, ctx)) // OK
+//
+// The policy is traced through SSA to its construction; policies of
+// unknown origin, such as parameters, are assumed OK.
+type Backoff struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*Backoff) Name() ignore.CheckerName {
+	return ignore.Backoff
+}
+
+// MatchCall returns true if the call is backoff.Retry or backoff.RetryNotify.
+func (*Backoff) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil || len(call.Args) <= backoffPolicyArgIdx {
+		return false
+	}
+	for _, spec := range backoffRetryFuncs {
+		if spec.Matches(fn) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCall checks that the backoff policy went through WithContext (SSA-based).
+func (*Backoff) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 || cctx.SSAProg == nil || cctx.Tracer == nil {
+		return internal.OK()
+	}
+
+	policy := cctx.SSAProg.ArgAt(call, backoffPolicyArgIdx)
+	if policy == nil {
+		return internal.OK() // Can't analyze, assume OK
+	}
+
+	if cctx.Tracer.BuiltWithout(policy, backoffWithContext) {
+		return internal.Fail("use backoff.WithContext so retries observe cancellation").WithConfidence(internal.ConfidenceMedium)
+	}
+	return internal.OK()
+}
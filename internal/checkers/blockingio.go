@@ -0,0 +1,77 @@
+package checkers
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// DefaultBlockingFuncs is the default value of the -blocking-funcs flag.
+const DefaultBlockingFuncs = "io.Copy,io.ReadAll,bufio.Scanner.Scan"
+
+// BlockingIO reports long-blocking I/O calls inside go statement closures
+// spawned where a context is in scope. Such calls can't be interrupted by
+// ctx cancellation unless the reader or writer is wrapped to observe it.
+// This is informational: the list of functions is deliberately narrow.
+type BlockingIO struct {
+	specs []funcspec.Spec
+}
+
+// NewBlockingIO creates a blocking I/O checker from a comma-separated
+// list of function specifications.
+func NewBlockingIO(funcs string) *BlockingIO {
+	c := &BlockingIO{}
+	for part := range strings.SplitSeq(funcs, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		c.specs = append(c.specs, funcspec.Parse(part))
+	}
+	return c
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*BlockingIO) Name() ignore.CheckerName {
+	return ignore.BlockingIO
+}
+
+// MatchCall returns true if the call is one of the configured blocking functions.
+func (c *BlockingIO) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return false
+	}
+	for _, spec := range c.specs {
+		if spec.Matches(fn) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCall reports the call if it is inside a go statement closure.
+func (*BlockingIO) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	file := cctx.FileOf(call.Pos())
+	if file == nil {
+		return internal.OK()
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, call.Pos(), call.End())
+	if !inGoStmtClosure(path) {
+		return internal.OK()
+	}
+
+	return internal.Fail("blocking I/O in goroutine cannot observe context cancellation").WithConfidence(internal.ConfidenceLow)
+}
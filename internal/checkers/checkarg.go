@@ -0,0 +1,61 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/deriver"
+	"github.com/mpyw/goroutinectx/internal/directive/checkarg"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// CheckArg checks call arguments marked as goroutine callbacks by
+// //goroutinectx:check-arg directives.
+type CheckArg struct {
+	maps     map[string]checkarg.Map
+	callback *SpawnCallbackChecker
+}
+
+// NewCheckArg creates a checker for the directives in maps (keyed by filename).
+func NewCheckArg(maps map[string]checkarg.Map, derivers *deriver.Matcher) *CheckArg {
+	return &CheckArg{
+		maps:     maps,
+		callback: NewSpawnCallbackChecker(ignore.CheckArg, nil, derivers),
+	}
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*CheckArg) Name() ignore.CheckerName {
+	return ignore.CheckArg
+}
+
+// MatchCall returns true if a check-arg directive applies to the call.
+func (c *CheckArg) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	return len(c.argsOf(pass, call)) > 0
+}
+
+// CheckCall checks each marked argument like a spawner callback.
+func (c *CheckArg) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	for _, idx := range c.argsOf(cctx.Pass, call) {
+		if idx >= len(call.Args) {
+			continue
+		}
+		ok, confidence := c.callback.checkArg(cctx, call, idx)
+		if ok {
+			continue
+		}
+		return internal.Fail(fmt.Sprintf("%s() argument %d should use %s", types.ExprString(call.Fun), idx, contextsPhrase(cctx, "one of"))).WithConfidence(confidence)
+	}
+	return internal.OK()
+}
+
+// argsOf returns the argument indices marked for the call.
+func (c *CheckArg) argsOf(pass *analysis.Pass, call *ast.CallExpr) []int {
+	pos := pass.Fset.Position(call.Pos())
+	return c.maps[pos.Filename].ArgsAt(pos.Line)
+}
@@ -0,0 +1,89 @@
+package checkers
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// ClosureStore reports closures capturing a context in scope that are
+// passed to functions storing them long-term, such as a package-level
+// registry. The request context stays reachable for as long as the
+// closure is registered:
+//
+//	registry.Add(func() { _ = ctx }) // reported
+//
+// The capture must be traced to an SSA free variable of the closure.
+type ClosureStore struct {
+	specs []funcspec.Spec
+}
+
+// NewClosureStore creates a stored closure checker from a comma-separated
+// list of function specifications.
+func NewClosureStore(funcs string) *ClosureStore {
+	c := &ClosureStore{}
+	for part := range strings.SplitSeq(funcs, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		c.specs = append(c.specs, funcspec.Parse(part))
+	}
+	return c
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*ClosureStore) Name() ignore.CheckerName {
+	return ignore.ClosureStore
+}
+
+// MatchCall returns true if the call is one of the configured store functions.
+func (c *ClosureStore) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return false
+	}
+	for _, spec := range c.specs {
+		if spec.Matches(fn) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCall reports the call if any closure argument captures a context.
+func (*ClosureStore) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 || cctx.SSAProg == nil || cctx.Tracer == nil {
+		return internal.OK()
+	}
+
+	for _, arg := range call.Args {
+		lit := closureArg(cctx, arg)
+		if lit == nil {
+			continue
+		}
+		if cctx.Tracer.ClosureCapturesContext(cctx.SSAProg.FindFuncLit(lit), cctx.Carriers) {
+			return internal.Fail("closure capturing request context stored in long-lived registry").WithConfidence(internal.ConfidenceMedium)
+		}
+	}
+
+	return internal.OK()
+}
+
+// closureArg returns the func literal passed as arg, directly or through a
+// variable, or nil.
+func closureArg(cctx *probe.Context, arg ast.Expr) *ast.FuncLit {
+	switch a := ast.Unparen(arg).(type) {
+	case *ast.FuncLit:
+		return a
+	case *ast.Ident:
+		return cctx.FuncLitOfIdent(a)
+	}
+	return nil
+}
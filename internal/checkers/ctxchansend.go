@@ -0,0 +1,56 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// CtxChanSend reports sends of context values on channels from inside go
+// statement closures. Handing a derived context back to the parent is
+// unusual and often means cancellation is wired the wrong way round.
+type CtxChanSend struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*CtxChanSend) Name() ignore.CheckerName {
+	return ignore.CtxChanSend
+}
+
+// NodeTypes returns the node types this checker inspects.
+func (*CtxChanSend) NodeTypes() []ast.Node {
+	return []ast.Node{
+		(*ast.SendStmt)(nil),
+	}
+}
+
+// CheckNode checks "ch <- ctx" where the channel element type is a context.
+func (*CtxChanSend) CheckNode(cctx *probe.Context, node ast.Node) *internal.Result {
+	send := node.(*ast.SendStmt)
+
+	typ := cctx.Pass.TypesInfo.TypeOf(send.Chan)
+	if typ == nil {
+		return internal.OK()
+	}
+	ch, ok := typ.Underlying().(*types.Chan)
+	if !ok || !typeutil.IsContextType(ch.Elem()) {
+		return internal.OK()
+	}
+
+	file := cctx.FileOf(send.Pos())
+	if file == nil {
+		return internal.OK()
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, send.Pos(), send.End())
+	if !inGoStmtClosure(path) {
+		return internal.OK()
+	}
+
+	return internal.Fail("sending context out of a goroutine via channel is unusual").WithConfidence(internal.ConfidenceLow)
+}
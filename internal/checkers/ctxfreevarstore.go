@@ -0,0 +1,109 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ssa"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// CtxFreeVarStore reports assignments to a context variable captured by a
+// go statement closure. The goroutine and its spawner share the variable,
+// so the assignment races with the spawner and leaks out of the goroutine:
+//
+//	go func() {
+//	    if ctx == nil {
+//	        ctx = context.Background() // reported
+//	    }
+//	    use(ctx)
+//	}()
+//
+// The assignment must be traced to a store to an SSA free variable.
+type CtxFreeVarStore struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*CtxFreeVarStore) Name() ignore.CheckerName {
+	return ignore.CtxFreeVarStore
+}
+
+// NodeTypes returns the node types this checker inspects.
+func (*CtxFreeVarStore) NodeTypes() []ast.Node {
+	return []ast.Node{
+		(*ast.AssignStmt)(nil),
+	}
+}
+
+// CheckNode checks "ctx = ..." where ctx is declared outside the goroutine.
+func (*CtxFreeVarStore) CheckNode(cctx *probe.Context, node ast.Node) *internal.Result {
+	assign := node.(*ast.AssignStmt)
+	if assign.Tok != token.ASSIGN {
+		return internal.OK()
+	}
+
+	file := cctx.FileOf(assign.Pos())
+	if file == nil {
+		return internal.OK()
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, assign.Pos(), assign.End())
+	goLit := goStmtClosure(path)
+	if goLit == nil {
+		return internal.OK()
+	}
+
+	for _, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		v, ok := cctx.Pass.TypesInfo.Uses[ident].(*types.Var)
+		if !ok || !typeutil.IsContextType(v.Type()) {
+			continue
+		}
+		if goLit.Pos() <= v.Pos() && v.Pos() < goLit.End() {
+			continue // Declared inside the goroutine
+		}
+		if storesToFreeVar(cctx, path, ident) {
+			return internal.Fail("reassigning captured context inside goroutine has surprising semantics").WithConfidence(internal.ConfidenceMedium)
+		}
+	}
+
+	return internal.OK()
+}
+
+// storesToFreeVar checks if the SSA function of the innermost func literal
+// in the path stores to a free variable at ident.
+func storesToFreeVar(cctx *probe.Context, path []ast.Node, ident *ast.Ident) bool {
+	var lit *ast.FuncLit
+	for _, node := range path {
+		if l, ok := node.(*ast.FuncLit); ok {
+			lit = l
+			break
+		}
+	}
+
+	fn := cctx.SSAProg.FindFuncLit(lit)
+	if fn == nil {
+		return false
+	}
+
+	for _, block := range fn.Blocks {
+		for _, instr := range block.Instrs {
+			store, ok := instr.(*ssa.Store)
+			if !ok || store.Pos() != ident.Pos() {
+				continue
+			}
+			if _, ok := store.Addr.(*ssa.FreeVar); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
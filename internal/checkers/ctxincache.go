@@ -0,0 +1,71 @@
+package checkers
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// DefaultCacheSetFuncs is the default value of the -cache-set-funcs flag.
+const DefaultCacheSetFuncs = "sync.Map.Store,sync.Map.LoadOrStore,sync.Map.Swap," +
+	"github.com/patrickmn/go-cache.Cache.Set,github.com/patrickmn/go-cache.Cache.SetDefault," +
+	"github.com/patrickmn/go-cache.Cache.Add"
+
+// CtxInCache reports contexts passed to functions storing values in a
+// long-lived cache. A request context outlives its request there, keeping
+// its values and cancellation state reachable:
+//
+//	cache.Set(key, ctx, ttl) // reported
+type CtxInCache struct {
+	specs []funcspec.Spec
+}
+
+// NewCtxInCache creates a cached context checker from a comma-separated
+// list of function specifications.
+func NewCtxInCache(funcs string) *CtxInCache {
+	c := &CtxInCache{}
+	for part := range strings.SplitSeq(funcs, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		c.specs = append(c.specs, funcspec.Parse(part))
+	}
+	return c
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*CtxInCache) Name() ignore.CheckerName {
+	return ignore.CtxInCache
+}
+
+// MatchCall returns true if the call is one of the configured cache functions.
+func (c *CtxInCache) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return false
+	}
+	for _, spec := range c.specs {
+		if spec.Matches(fn) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCall reports the call if any argument is a context.
+func (*CtxInCache) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	for _, arg := range call.Args {
+		if typeutil.IsContextType(cctx.Pass.TypesInfo.TypeOf(arg)) {
+			return internal.Fail("storing context.Context in a cache may leak request state").WithConfidence(internal.ConfidenceLow)
+		}
+	}
+	return internal.OK()
+}
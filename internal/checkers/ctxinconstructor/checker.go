@@ -0,0 +1,154 @@
+package ctxinconstructor
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const checkerName = ignore.CtxInConstructor
+
+// Checker reports constructors storing their context parameter on the
+// struct they return.
+type Checker struct{}
+
+// New creates a new ctxinconstructor checker.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Check runs the ctxinconstructor analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return
+		}
+
+		decl := n.(*ast.FuncDecl)
+		if decl.Recv != nil || decl.Body == nil {
+			return
+		}
+
+		results := constructedTypes(pass, decl)
+		params := ctxParams(pass, decl)
+		if len(results) == 0 || len(params) == 0 {
+			return
+		}
+
+		for _, value := range storedCtxParams(pass, decl.Body, results, params) {
+			line := pass.Fset.Position(value.Pos()).Line
+			if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+				continue
+			}
+
+			pass.Report(analysis.Diagnostic{
+				Pos:      value.Pos(),
+				Category: string(checkerName),
+				Message:  "storing the constructor's context on the struct field is discouraged",
+			})
+		}
+	})
+}
+
+// constructedTypes returns the named struct types the function returns,
+// directly or through a pointer.
+func constructedTypes(pass *analysis.Pass, decl *ast.FuncDecl) map[*types.Named]bool {
+	if decl.Type.Results == nil {
+		return nil
+	}
+
+	result := make(map[*types.Named]bool)
+	for _, field := range decl.Type.Results.List {
+		if named := namedStructOf(pass.TypesInfo.TypeOf(field.Type)); named != nil {
+			result[named] = true
+		}
+	}
+	return result
+}
+
+// namedStructOf returns the named struct type of t or *t, or nil.
+func namedStructOf(t types.Type) *types.Named {
+	if t == nil {
+		return nil
+	}
+	named, ok := typeutil.UnwrapPointer(t).(*types.Named)
+	if !ok {
+		return nil
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil
+	}
+	return named.Origin()
+}
+
+// ctxParams returns the context-typed parameters of the function.
+func ctxParams(pass *analysis.Pass, decl *ast.FuncDecl) map[types.Object]bool {
+	result := make(map[types.Object]bool)
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			if obj := pass.TypesInfo.Defs[name]; obj != nil && typeutil.IsContextType(obj.Type()) {
+				result[obj] = true
+			}
+		}
+	}
+	return result
+}
+
+// storedCtxParams returns the context parameter references stored on a field
+// of a constructed type, either in a keyed composite literal or by a field
+// assignment. Nested func literals are not inspected.
+func storedCtxParams(pass *analysis.Pass, body *ast.BlockStmt, results map[*types.Named]bool, params map[types.Object]bool) []ast.Expr {
+	isParam := func(expr ast.Expr) bool {
+		ident, ok := ast.Unparen(expr).(*ast.Ident)
+		return ok && params[pass.TypesInfo.Uses[ident]]
+	}
+	isCtxField := func(ident *ast.Ident) bool {
+		field, ok := pass.TypesInfo.ObjectOf(ident).(*types.Var)
+		return ok && field.IsField() && typeutil.IsContextType(field.Type())
+	}
+
+	var stored []ast.Expr
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.CompositeLit:
+			if !results[namedStructOf(pass.TypesInfo.TypeOf(n))] {
+				return true
+			}
+			for _, elt := range n.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				if ok && isCtxField(key) && isParam(kv.Value) {
+					stored = append(stored, kv.Value)
+				}
+			}
+
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				sel, ok := lhs.(*ast.SelectorExpr)
+				if !ok || !isCtxField(sel.Sel) || !results[namedStructOf(pass.TypesInfo.TypeOf(sel.X))] {
+					continue
+				}
+				if isParam(n.Rhs[i]) {
+					stored = append(stored, n.Rhs[i])
+				}
+			}
+		}
+		return true
+	})
+	return stored
+}
@@ -0,0 +1,29 @@
+// Package ctxinconstructor reports constructors storing their context
+// parameter on the struct they return.
+//
+// # Overview
+//
+// A context belongs to a single call. A constructor that keeps its context
+// parameter on the returned struct makes every later method call observe
+// that first caller's deadline and values:
+//
+//	func NewServer(ctx context.Context) *Server {
+//	    return &Server{ctx: ctx} // Warning
+//	}
+//
+//	func NewServer(ctx context.Context) *Server {
+//	    s := &Server{}
+//	    s.ctx = ctx // Warning
+//	    return s
+//	}
+//
+// A constructor is any package-level function returning a named struct type
+// or a pointer to one. Only the parameter itself is reported; contexts
+// derived from it, and fields of other types, are not.
+//
+// # Why Separate?
+//
+// The stored context is the constructor's own parameter, not one in scope
+// at a goroutine or call site, so the main runner has nothing to compare it
+// with. This checker therefore walks every function declaration.
+package ctxinconstructor
@@ -0,0 +1,72 @@
+package ctxindto
+
+import (
+	"go/ast"
+	"reflect"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const checkerName = ignore.CtxInDTO
+
+// Checker reports context.Context fields in structs with json struct tags.
+type Checker struct{}
+
+// New creates a new ctxindto checker.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Check runs the ctxindto analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.Preorder([]ast.Node{(*ast.StructType)(nil)}, func(n ast.Node) {
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return
+		}
+
+		st := n.(*ast.StructType)
+		if !hasJSONTag(st) {
+			return
+		}
+
+		for _, field := range st.Fields.List {
+			if !typeutil.IsContextType(pass.TypesInfo.TypeOf(field.Type)) {
+				continue
+			}
+
+			line := pass.Fset.Position(field.Pos()).Line
+			if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+				continue
+			}
+
+			pass.Report(analysis.Diagnostic{
+				Pos:      field.Pos(),
+				Category: string(checkerName),
+				Message:  "context.Context field in a JSON-serialized struct",
+			})
+		}
+	})
+}
+
+// hasJSONTag checks if any field of the struct has a json struct tag.
+func hasJSONTag(st *ast.StructType) bool {
+	for _, field := range st.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		if _, ok := reflect.StructTag(tag).Lookup("json"); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+// Package ctxindto reports context.Context fields in JSON-serialized structs.
+//
+// # Overview
+//
+// A context is not serializable. A request or response struct that carries
+// one alongside JSON-tagged fields is almost always a mistake; the context
+// should be passed as a function parameter instead:
+//
+//	type CreateUserRequest struct {
+//	    Ctx  context.Context // Warning
+//	    Name string `json:"name"`
+//	}
+//
+// A struct is treated as a DTO when any of its other fields has a json
+// struct tag. Structs without json tags are not reported.
+//
+// # Why Separate?
+//
+// Struct types are usually declared at package level, outside any function
+// with a context.Context in scope, so the main runner never visits them.
+// This checker therefore walks every struct type in the package.
+package ctxindto
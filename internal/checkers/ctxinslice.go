@@ -0,0 +1,87 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// CtxInSlice reports contexts stored into slices or maps inside go statement
+// closures, either through append or an index assignment. Collecting
+// contexts from goroutines usually means one outlives its request.
+type CtxInSlice struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*CtxInSlice) Name() ignore.CheckerName {
+	return ignore.CtxInSlice
+}
+
+// NodeTypes returns the node types this checker inspects.
+func (*CtxInSlice) NodeTypes() []ast.Node {
+	return []ast.Node{
+		(*ast.AssignStmt)(nil),
+	}
+}
+
+// CheckNode checks "s = append(s, ctx)" and "m[k] = ctx" inside goroutines.
+func (*CtxInSlice) CheckNode(cctx *probe.Context, node ast.Node) *internal.Result {
+	assign := node.(*ast.AssignStmt)
+
+	if !storesCtx(cctx, assign) {
+		return internal.OK()
+	}
+
+	file := cctx.FileOf(assign.Pos())
+	if file == nil {
+		return internal.OK()
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, assign.Pos(), assign.End())
+	if !inGoStmtClosure(path) {
+		return internal.OK()
+	}
+
+	return internal.Fail("context stored in a slice or map inside goroutine")
+}
+
+// storesCtx checks if the assignment appends a context or assigns one to an index.
+func storesCtx(cctx *probe.Context, assign *ast.AssignStmt) bool {
+	for i, rhs := range assign.Rhs {
+		if call, ok := ast.Unparen(rhs).(*ast.CallExpr); ok && isAppendCall(cctx, call) {
+			for _, arg := range call.Args[1:] {
+				if isCtxTyped(cctx, arg) {
+					return true
+				}
+			}
+		}
+
+		if i < len(assign.Lhs) && len(assign.Lhs) == len(assign.Rhs) {
+			if _, ok := ast.Unparen(assign.Lhs[i]).(*ast.IndexExpr); ok && isCtxTyped(cctx, rhs) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isAppendCall checks if the call is the append builtin.
+func isAppendCall(cctx *probe.Context, call *ast.CallExpr) bool {
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok || len(call.Args) < 2 {
+		return false
+	}
+	b, ok := cctx.Pass.TypesInfo.Uses[ident].(*types.Builtin)
+	return ok && b.Name() == "append"
+}
+
+// isCtxTyped checks if the expression has type context.Context.
+func isCtxTyped(cctx *probe.Context, expr ast.Expr) bool {
+	typ := cctx.Pass.TypesInfo.TypeOf(expr)
+	return typ != nil && typeutil.IsContextType(typ)
+}
@@ -0,0 +1,58 @@
+package ctxmapkey
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const checkerName = ignore.CtxMapKey
+
+// Checker reports contexts used as map keys.
+type Checker struct{}
+
+// New creates a new ctxmapkey checker.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Check runs the ctxmapkey analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.Preorder([]ast.Node{(*ast.IndexExpr)(nil)}, func(n ast.Node) {
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return
+		}
+
+		index := n.(*ast.IndexExpr)
+		if !isMapIndex(pass, index) || !typeutil.IsContextType(pass.TypesInfo.TypeOf(index.Index)) {
+			return
+		}
+
+		line := pass.Fset.Position(index.Index.Pos()).Line
+		if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+			return
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      index.Index.Pos(),
+			Category: string(checkerName),
+			Message:  "context.Context used as a map key",
+		})
+	})
+}
+
+// isMapIndex checks if the index expression indexes a map.
+func isMapIndex(pass *analysis.Pass, index *ast.IndexExpr) bool {
+	t := pass.TypesInfo.TypeOf(index.X)
+	if t == nil {
+		return false
+	}
+	_, ok := t.Underlying().(*types.Map)
+	return ok
+}
@@ -0,0 +1,21 @@
+// Package ctxmapkey reports contexts used as map keys.
+//
+// # Overview
+//
+// Contexts are compared by identity, and every derived context is a new
+// value, so a map keyed by context.Context almost never finds what was
+// stored for the "same" request and keeps every context alive:
+//
+//	seen[ctx] = true      // Warning
+//	if seen[ctx] { ... }  // Warning
+//
+//	seen[requestID(ctx)] = true // OK
+//
+// Both assignments and lookups through index expressions are reported.
+//
+// # Why Separate?
+//
+// A map keyed by context is a bug wherever it appears, whether or not a
+// context variable is in scope. This checker therefore walks every function
+// rather than only context-aware ones.
+package ctxmapkey
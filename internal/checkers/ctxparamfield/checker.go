@@ -0,0 +1,131 @@
+package ctxparamfield
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const checkerName = ignore.CtxParamField
+
+// Checker reports unused context parameters of methods reading a receiver context field.
+type Checker struct{}
+
+// New creates a new ctxparamfield checker.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Check runs the ctxparamfield analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return
+		}
+
+		decl := n.(*ast.FuncDecl)
+		recv := receiverOf(pass, decl)
+		if recv == nil || decl.Body == nil {
+			return
+		}
+
+		field := receiverCtxFieldRead(pass, decl.Body, recv)
+		if field == "" {
+			return
+		}
+
+		for _, param := range unusedCtxParams(pass, decl) {
+			line := pass.Fset.Position(param.Pos()).Line
+			if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+				continue
+			}
+
+			pass.Report(analysis.Diagnostic{
+				Pos:      param.Pos(),
+				Category: string(checkerName),
+				Message:  fmt.Sprintf("%s parameter shadowed by %s.%s field; one is unused", param.Name, recv.Name(), field),
+			})
+		}
+	})
+}
+
+// receiverOf returns the named receiver of a method declaration, or nil.
+func receiverOf(pass *analysis.Pass, decl *ast.FuncDecl) types.Object {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 || len(decl.Recv.List[0].Names) == 0 {
+		return nil
+	}
+	name := decl.Recv.List[0].Names[0]
+	if name.Name == "_" {
+		return nil
+	}
+	return pass.TypesInfo.Defs[name]
+}
+
+// receiverCtxFieldRead returns the name of a context-typed field read through
+// the receiver in body, or "" if there is none.
+func receiverCtxFieldRead(pass *analysis.Pass, body *ast.BlockStmt, recv types.Object) string {
+	var result string
+	ast.Inspect(body, func(n ast.Node) bool {
+		if result != "" {
+			return false
+		}
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		x, ok := ast.Unparen(sel.X).(*ast.Ident)
+		if !ok || pass.TypesInfo.Uses[x] != recv {
+			return true
+		}
+		field, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Var)
+		if ok && field.IsField() && typeutil.IsContextType(field.Type()) {
+			result = sel.Sel.Name
+		}
+		return true
+	})
+	return result
+}
+
+// unusedCtxParams returns the named context parameters never referenced in the body.
+func unusedCtxParams(pass *analysis.Pass, decl *ast.FuncDecl) []*ast.Ident {
+	if decl.Type.Params == nil {
+		return nil
+	}
+
+	var result []*ast.Ident
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			if name.Name == "_" {
+				continue
+			}
+			obj := pass.TypesInfo.Defs[name]
+			if obj == nil || !typeutil.IsContextType(obj.Type()) || isUsedIn(pass, decl.Body, obj) {
+				continue
+			}
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// isUsedIn checks if obj is referenced anywhere in body.
+func isUsedIn(pass *analysis.Pass, body *ast.BlockStmt, obj types.Object) bool {
+	used := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if used {
+			return false
+		}
+		if ident, ok := n.(*ast.Ident); ok && pass.TypesInfo.Uses[ident] == obj {
+			used = true
+		}
+		return !used
+	})
+	return used
+}
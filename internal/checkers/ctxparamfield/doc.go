@@ -0,0 +1,21 @@
+// Package ctxparamfield reports methods ignoring their context parameter in
+// favor of a receiver context field.
+//
+// # Overview
+//
+// A method that receives a context but reads a context stored on its
+// receiver is confusing; one of the two is effectively unused:
+//
+//	func (s *server) handle(ctx context.Context) error {
+//	    return s.db.Query(s.ctx) // Warning: ctx is never used
+//	}
+//
+// Only methods whose context parameter is never referenced and whose body
+// reads a context-typed field through the receiver are reported.
+//
+// # Why Separate?
+//
+// The main runner dispatches the nodes inside context-aware functions, not
+// the function declarations themselves. This checker therefore walks every
+// method declaration.
+package ctxparamfield
@@ -0,0 +1,99 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// ctxRequiredFunc is a function whose argument at argIdx must be an in-scope context.
+type ctxRequiredFunc struct {
+	spec   funcspec.Spec
+	argIdx int
+}
+
+// CtxRequired reports calls to configured functions whose context argument
+// does not reference a context in scope, such as context.Background():
+//
+//	logger.With(requestFields(context.Background())...) // request ID lost
+//
+// The functions are typically helpers extracting log fields from ctx.
+type CtxRequired struct {
+	funcs []ctxRequiredFunc
+}
+
+// NewCtxRequired creates a required context checker from a comma-separated
+// list of "pkg/path.Func:argIndex" entries. The index defaults to 0;
+// entries with an invalid index are skipped.
+func NewCtxRequired(funcs string) *CtxRequired {
+	c := &CtxRequired{}
+	for part := range strings.SplitSeq(funcs, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, idxStr, hasIdx := strings.Cut(part, ":")
+		argIdx := 0
+		if hasIdx {
+			n, err := strconv.Atoi(idxStr)
+			if err != nil || n < 0 {
+				continue
+			}
+			argIdx = n
+		}
+
+		c.funcs = append(c.funcs, ctxRequiredFunc{spec: funcspec.Parse(name), argIdx: argIdx})
+	}
+	return c
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*CtxRequired) Name() ignore.CheckerName {
+	return ignore.CtxRequired
+}
+
+// MatchCall returns true if the call is one of the configured functions.
+func (c *CtxRequired) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	return c.match(pass, call) != nil
+}
+
+// CheckCall checks that the context argument references a context in scope.
+func (c *CtxRequired) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	f := c.match(cctx.Pass, call)
+	if f == nil || f.argIdx >= len(call.Args) {
+		return internal.OK()
+	}
+
+	if cctx.ArgUsesContext(call.Args[f.argIdx]) {
+		return internal.OK()
+	}
+
+	return internal.Fail(fmt.Sprintf("pass context %q to %s", cctx.CtxNames[0], f.spec.FuncName))
+}
+
+// match returns the configured function matching the call, or nil.
+func (c *CtxRequired) match(pass *analysis.Pass, call *ast.CallExpr) *ctxRequiredFunc {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return nil
+	}
+	for i := range c.funcs {
+		if c.funcs[i].spec.Matches(fn) {
+			return &c.funcs[i]
+		}
+	}
+	return nil
+}
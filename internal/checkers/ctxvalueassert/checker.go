@@ -0,0 +1,90 @@
+package ctxvalueassert
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const checkerName = ignore.CtxValueAssert
+
+// Checker reports ctx.Value(...).(T) assertions without comma-ok.
+type Checker struct{}
+
+// New creates a new ctxvalueassert checker.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Check runs the ctxvalueassert analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.WithStack([]ast.Node{(*ast.TypeAssertExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return true
+		}
+
+		assert := n.(*ast.TypeAssertExpr)
+		if assert.Type == nil || !isCtxValueCall(pass, assert.X) || usesCommaOk(stack) {
+			return true
+		}
+
+		line := pass.Fset.Position(assert.Pos()).Line
+		if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+			return true
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      assert.Pos(),
+			Category: string(checkerName),
+			Message:  "type assertion on ctx.Value result should use comma-ok",
+		})
+		return true
+	})
+}
+
+// isCtxValueCall checks if the expression is a Value(key) call on a context.Context.
+func isCtxValueCall(pass *analysis.Pass, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Value" {
+		return false
+	}
+	return typeutil.IsContextType(pass.TypesInfo.TypeOf(sel.X))
+}
+
+// usesCommaOk checks if the assertion is the single value of a two-value
+// assignment or declaration, such as "v, ok := ctx.Value(key).(T)".
+// stack ends with the assertion itself.
+func usesCommaOk(stack []ast.Node) bool {
+	// Skip enclosing parentheses
+	i := len(stack) - 2
+	for i >= 0 {
+		if _, ok := stack[i].(*ast.ParenExpr); !ok {
+			break
+		}
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+
+	switch parent := stack[i].(type) {
+	case *ast.AssignStmt:
+		return len(parent.Lhs) == 2 && len(parent.Rhs) == 1
+	case *ast.ValueSpec:
+		return len(parent.Names) == 2 && len(parent.Values) == 1
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+// Package ctxvalueassert reports unchecked type assertions on ctx.Value results.
+//
+// # Overview
+//
+// ctx.Value returns nil when the key is missing, so a single-value type
+// assertion on its result panics instead of falling back:
+//
+//	user := ctx.Value(userKey).(*User) // Warning
+//
+//	user, ok := ctx.Value(userKey).(*User) // OK
+//	if !ok {
+//	    return errNoUser
+//	}
+//
+// Type switches are not reported; they never panic.
+//
+// # Why Separate?
+//
+// Values are often read from contexts that aren't in scope as a variable,
+// such as r.Context().Value(key) in HTTP handlers. This checker therefore
+// walks every function rather than only context-aware ones.
+package ctxvalueassert
@@ -10,6 +10,8 @@
 //	│ GoStmtChecker        │ Checks go statements                         │
 //	│  - Goroutine         │ go func() { ... }() without ctx              │
 //	│  - GoroutineDerive   │ go func() { ... }() without deriver call     │
+//	│  - RedundantDerive   │ deriver called twice in go func (opt-in)     │
+//	│  - StaleCtx          │ go s.loop() using s.ctx field (opt-in)       │
 //	├──────────────────────┼──────────────────────────────────────────────┤
 //	│ CallChecker          │ Checks function call expressions             │
 //	│  - CallArgChecker    │ Generic callback argument checker            │
@@ -18,6 +20,32 @@
 //	│    - Conc            │ github.com/sourcegraph/conc callbacks        │
 //	│  - SpawnerChecker    │ //goroutinectx:spawner marked functions      │
 //	│  - GotaskChecker     │ gotask library functions                     │
+//	│  - Signal            │ signal.Notify with ctx in scope (opt-in)     │
+//	│  - UnusedGroupCtx    │ errgroup.WithContext ctx unused (opt-in)     │
+//	│  - TimeTick          │ time.Tick with ctx in scope (opt-in)         │
+//	│  - WithoutCancel     │ WithoutCancel in go closure (opt-in)         │
+//	│  - ReturnCtxErr      │ errgroup Done case returns nil (opt-in)      │
+//	│  - BlockingIO        │ blocking I/O in go closure (opt-in)          │
+//	│  - UseAfterCancel    │ ctx passed on after cancel() (opt-in)        │
+//	│  - CtxRequired       │ -ctx-required-funcs arg without ctx          │
+//	│  - ExecCommand       │ exec.Command with ctx in scope (opt-in)      │
+//	│  - SlogCtx           │ slog.Info etc. with ctx in scope (opt-in)    │
+//	│  - CheckArg          │ //goroutinectx:check-arg marked arguments    │
+//	│  - PrintCtx          │ fmt.Println(ctx) etc. (opt-in)               │
+//	│  - LoopBackground    │ context.Background() in loop body (opt-in)   │
+//	│  - StdLog            │ log.Printf etc. in go closure (opt-in)       │
+//	│  - Transitive        │ call to ctx-less helper that spawns (opt-in) │
+//	│  - ClosureStore      │ -closure-store-funcs closure capturing ctx   │
+//	├──────────────────────┼──────────────────────────────────────────────┤
+//	│ NodeChecker          │ Checks other node types                      │
+//	│  - NilCtx            │ ctx assigned/compared to nil (opt-in)        │
+//	│  - CtxInSlice        │ ctx stored in slice/map in go (opt-in)       │
+//	│  - CtxChanSend       │ ctx sent on channel from go (opt-in)         │
+//	│  - WaitError         │ _ = g.Wait() with ctx in scope (opt-in)      │
+//	│  - IgnoredCtxErr     │ _ = ctx.Err() or bare ctx.Err() (opt-in)     │
+//	│  - CtxlessHandlerMap │ ctx-less func literal in map (opt-in)        │
+//	│  - UnguardedChanOp   │ ch <- v in go closure w/o Done (opt-in)      │
+//	│  - CtxFreeVarStore   │ captured ctx reassigned in go (opt-in)       │
 //	└──────────────────────┴──────────────────────────────────────────────┘
 //
 // # GoStmtChecker
@@ -68,6 +96,9 @@
 //	    }()
 //	}
 //
+// SpawnerChecker requires func arguments of //goroutinectx:spawner functions
+// that receive their own context parameter to call the deriver as well.
+//
 // # Gotask Checker
 //
 // Checks gotask library usage for proper context derivation:
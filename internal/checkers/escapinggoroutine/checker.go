@@ -0,0 +1,125 @@
+package escapinggoroutine
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/registry"
+)
+
+const checkerName = ignore.EscapingGoroutine
+
+// Checker reports go statements inside closures run by a managed group.
+type Checker struct {
+	reg *registry.Registry
+}
+
+// New creates a new escapinggoroutine checker for the group APIs in reg.
+func New(reg *registry.Registry) *Checker {
+	return &Checker{reg: reg}
+}
+
+// Check runs the escapinggoroutine analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.WithStack([]ast.Node{(*ast.GoStmt)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return true
+		}
+
+		callback := c.enclosingCallback(pass, stack)
+		if callback == nil || waitsInBody(pass, callback) {
+			return true
+		}
+
+		line := pass.Fset.Position(n.Pos()).Line
+		if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+			return true
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      n.Pos(),
+			Category: string(checkerName),
+			Message:  "bare goroutine inside a managed group escapes the group",
+		})
+		return true
+	})
+}
+
+// enclosingCallback returns the func literal passed to a group API whose
+// body runs the node at the top of the stack, following immediately
+// invoked func literals only.
+func (c *Checker) enclosingCallback(pass *analysis.Pass, stack []ast.Node) *ast.FuncLit {
+	for i := len(stack) - 2; i > 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl:
+			return nil
+
+		case *ast.FuncLit:
+			call, ok := stack[i-1].(*ast.CallExpr)
+			if !ok {
+				return nil // Stored for later, if called at all
+			}
+			if ast.Unparen(call.Fun) == n {
+				continue // Runs in place
+			}
+			if c.isCallbackArg(pass, call, n) {
+				return n
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// isCallbackArg checks if lit is the callback argument of a group API call.
+func (c *Checker) isCallbackArg(pass *analysis.Pass, call *ast.CallExpr, lit *ast.FuncLit) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return false
+	}
+	match := c.reg.MatchFunc(fn)
+	if match == nil || match.CallbackArgIdx >= len(call.Args) {
+		return false
+	}
+	return call.Args[match.CallbackArgIdx] == lit
+}
+
+// waitsInBody checks if the callback calls a Wait method, joining the
+// goroutines it starts before returning:
+//
+//	g.Go(func() error {
+//	    var wg sync.WaitGroup
+//	    wg.Add(1)
+//	    go func() { defer wg.Done() }()
+//	    wg.Wait()
+//	    return nil
+//	})
+func waitsInBody(pass *analysis.Pass, lit *ast.FuncLit) bool {
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if fn := funcspec.ExtractFunc(pass, call); fn != nil && fn.Name() == "Wait" && fn.Signature().Recv() != nil {
+			found = true
+		}
+		return true
+	})
+	return found
+}
@@ -0,0 +1,33 @@
+// Package escapinggoroutine reports bare goroutines started inside closures
+// run by errgroup, sync.WaitGroup or conc.
+//
+// # Overview
+//
+// A managed group waits for the closures it runs, but not for goroutines
+// those closures start with a go statement. Such a goroutine outlives
+// the group, so Wait returns while it is still running:
+//
+//	g := new(errgroup.Group)
+//	g.Go(func() error {
+//	    go leak() // Warning
+//	    return nil
+//	})
+//	_ = g.Wait()
+//
+// Func literals are followed only when invoked immediately, and closures
+// that call a Wait method themselves are assumed to join their goroutines:
+//
+//	g.Go(func() error {
+//	    var wg sync.WaitGroup
+//	    wg.Add(1)
+//	    go func() { defer wg.Done() }() // OK: joined below
+//	    wg.Wait()
+//	    return nil
+//	})
+//
+// # Why Separate?
+//
+// The leak does not depend on a context being in scope, so the main runner,
+// which only visits context-aware functions, cannot find every case. This
+// checker therefore walks every go statement in the package.
+package escapinggoroutine
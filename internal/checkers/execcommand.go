@@ -0,0 +1,68 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// execCommand starts processes that are not killed when a context is canceled.
+var execCommand = funcspec.Spec{PkgPath: "os/exec", FuncName: "Command"}
+
+// ExecCommand reports exec.Command calls where a context is in scope,
+// including inside goroutine closures that inherit the outer context.
+// exec.CommandContext kills the process when the context is done.
+type ExecCommand struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*ExecCommand) Name() ignore.CheckerName {
+	return ignore.ExecCommand
+}
+
+// MatchCall returns true if the call is exec.Command.
+func (*ExecCommand) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	return fn != nil && execCommand.Matches(fn)
+}
+
+// CheckCall reports the call with a fix rewriting it to exec.CommandContext.
+func (*ExecCommand) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	ctxName := cctx.CtxNames[0]
+	msg := fmt.Sprintf("use exec.CommandContext with context %q instead of exec.Command", ctxName)
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return internal.Fail(msg)
+	}
+
+	// Carriers and names out of scope cannot be passed as the context.
+	if _, ok := cctx.ContextExprAt(call.Pos()); !ok {
+		return internal.Fail(msg)
+	}
+
+	return internal.FailWithFix(msg, analysis.SuggestedFix{
+		Message: "Replace exec.Command with exec.CommandContext",
+		TextEdits: []analysis.TextEdit{
+			{
+				Pos:     sel.Sel.Pos(),
+				End:     sel.Sel.End(),
+				NewText: []byte("CommandContext"),
+			},
+			{
+				Pos:     call.Lparen + 1,
+				End:     call.Lparen + 1,
+				NewText: []byte(ctxName + ", "),
+			},
+		},
+	})
+}
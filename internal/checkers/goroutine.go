@@ -1,16 +1,38 @@
 package checkers
 
 import (
+	"fmt"
 	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
 
 	"github.com/mpyw/goroutinectx/internal"
 	"github.com/mpyw/goroutinectx/internal/deriver"
 	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
 	"github.com/mpyw/goroutinectx/internal/probe"
 )
 
 // Goroutine checks that go statements propagate context.
-type Goroutine struct{}
+type Goroutine struct {
+	style         MessageStyle
+	fireAndForget []funcspec.Spec
+}
+
+// NewGoroutine creates a new Goroutine checker reporting in the given style.
+// Goroutines only calling the comma-separated fireAndForgetFuncs are exempt.
+func NewGoroutine(style MessageStyle, fireAndForgetFuncs string) *Goroutine {
+	c := &Goroutine{style: style}
+	for part := range strings.SplitSeq(fireAndForgetFuncs, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		c.fireAndForget = append(c.fireAndForget, funcspec.Parse(part))
+	}
+	return c
+}
 
 // Name returns the checker name for ignore directive matching.
 func (*Goroutine) Name() ignore.CheckerName {
@@ -19,33 +41,138 @@ func (*Goroutine) Name() ignore.CheckerName {
 
 // CheckGoStmt checks a go statement for context propagation.
 func (c *Goroutine) CheckGoStmt(cctx *probe.Context, stmt *ast.GoStmt) *internal.Result {
-	if len(cctx.CtxNames) == 0 {
+	if len(cctx.CtxNames) == 0 || c.firesAndForgets(cctx.Pass, stmt) {
 		return internal.OK()
 	}
 
+	if !c.propagates(cctx, stmt) {
+		return internal.Fail(c.message(cctx, stmt))
+	}
+
+	// Strict functions require the closure to pass ctx to a call
+	if lit, ok := stmt.Call.Fun.(*ast.FuncLit); ok && cctx.Strict && !cctx.FuncLitPassesContext(lit) {
+		return internal.Fail(fmt.Sprintf("goroutine in strict function should pass context %q to a call", cctx.CtxNames[0]))
+	}
+	return internal.OK()
+}
+
+// propagates checks if the go statement uses the context in scope.
+func (c *Goroutine) propagates(cctx *probe.Context, stmt *ast.GoStmt) bool {
 	// Try SSA-based check first
 	if lit, ok := stmt.Call.Fun.(*ast.FuncLit); ok {
 		if result, ok := cctx.FuncLitCapturesContextSSA(lit); ok {
-			if result {
-				return internal.OK()
-			}
-			return internal.Fail(c.message(cctx))
+			return result || cctx.FuncLitPropagatesContext(lit)
 		}
 	}
 
 	// Fall back to AST-based check
-	if c.checkFromAST(cctx, stmt) {
-		return internal.OK()
+	return c.checkFromAST(cctx, stmt)
+}
+
+// firesAndForgets checks if the go statement only calls fire-and-forget
+// functions, which need no context:
+//
+//	go func() { reportError(err) }()
+func (c *Goroutine) firesAndForgets(pass *analysis.Pass, stmt *ast.GoStmt) bool {
+	if len(c.fireAndForget) == 0 {
+		return false
 	}
-	return internal.Fail(c.message(cctx))
+
+	lit, ok := stmt.Call.Fun.(*ast.FuncLit)
+	if !ok {
+		return c.isFireAndForget(pass, stmt.Call)
+	}
+	if len(lit.Body.List) == 0 {
+		return false
+	}
+
+	for _, s := range lit.Body.List {
+		expr, ok := s.(*ast.ExprStmt)
+		if !ok {
+			return false
+		}
+		call, ok := ast.Unparen(expr.X).(*ast.CallExpr)
+		if !ok || !c.isFireAndForget(pass, call) {
+			return false
+		}
+	}
+	return true
 }
 
-func (c *Goroutine) message(cctx *probe.Context) string {
-	ctxName := "ctx"
-	if len(cctx.CtxNames) > 0 {
-		ctxName = cctx.CtxNames[0]
+// isFireAndForget checks if the call is to a fire-and-forget function, with
+// a receiver and arguments running no other calls or func literals.
+func (c *Goroutine) isFireAndForget(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return false
+	}
+	for _, spec := range c.fireAndForget {
+		if spec.Matches(fn) {
+			exprs := call.Args
+			if sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok {
+				exprs = append([]ast.Expr{sel.X}, exprs...)
+			}
+			return c.onlyFiresAndForgets(pass, exprs)
+		}
 	}
-	return "goroutine does not propagate context \"" + ctxName + "\""
+	return false
+}
+
+// onlyFiresAndForgets checks if the expressions call nothing but
+// fire-and-forget functions and type conversions, and hold no func
+// literals, so that no other work runs in the goroutine:
+//
+//	go reportError(doWork()) // doWork needs the context
+func (c *Goroutine) onlyFiresAndForgets(pass *analysis.Pass, exprs []ast.Expr) bool {
+	ok := true
+	for _, expr := range exprs {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if !ok {
+				return false
+			}
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				ok = false
+			case *ast.CallExpr:
+				if tv, found := pass.TypesInfo.Types[n.Fun]; found && tv.IsType() {
+					return true
+				}
+				ok = c.isFireAndForget(pass, n)
+				return false
+			}
+			return ok
+		})
+	}
+	return ok
+}
+
+func (c *Goroutine) message(cctx *probe.Context, stmt *ast.GoStmt) string {
+	if c.style == MessageStyleUnified {
+		if lit, ok := stmt.Call.Fun.(*ast.FuncLit); ok && createsRootContext(cctx.Pass, lit) {
+			return "goroutine creates a fresh context instead of propagating " + contextsPhrase(cctx, "any of")
+		}
+		return "go statement closure should use " + contextsPhrase(cctx, "one of")
+	}
+	return "goroutine does not propagate " + contextsPhrase(cctx, "any of")
+}
+
+// createsRootContext checks if the func literal calls context.Background()
+// or context.TODO() itself, ignoring nested func literals.
+func createsRootContext(pass *analysis.Pass, lit *ast.FuncLit) bool {
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok && rootContextFunc(pass, call) != "" {
+			found = true
+		}
+		return !found
+	})
+	return found
 }
 
 // checkFromAST falls back to AST-based analysis for go statements.
@@ -53,7 +180,7 @@ func (*Goroutine) checkFromAST(cctx *probe.Context, stmt *ast.GoStmt) bool {
 	call := stmt.Call
 
 	if lit, ok := call.Fun.(*ast.FuncLit); ok {
-		return cctx.FuncLitCapturesContext(lit)
+		return cctx.FuncLitCapturesContext(lit) || cctx.FuncLitPropagatesContext(lit)
 	}
 
 	if innerCall, ok := call.Fun.(*ast.CallExpr); ok {
@@ -63,7 +190,7 @@ func (*Goroutine) checkFromAST(cctx *probe.Context, stmt *ast.GoStmt) bool {
 	if ident, ok := call.Fun.(*ast.Ident); ok {
 		assigns := cctx.FuncLitAssignmentsOfIdent(ident)
 		if len(assigns) == 0 {
-			return true
+			return cctx.FactoryResultOfIdentUsesContext(ident)
 		}
 		return cctx.FuncLitsAllCaptureContext(assigns)
 	}
@@ -81,12 +208,18 @@ func (*Goroutine) checkFromAST(cctx *probe.Context, stmt *ast.GoStmt) bool {
 
 // GoroutineDerive checks that go statements call a deriver function.
 type GoroutineDerive struct {
-	derivers *deriver.Matcher
+	derivers       *deriver.Matcher
+	style          MessageStyle
+	explainMissing bool
 }
 
-// NewGoroutineDerive creates a new GoroutineDerive checker.
-func NewGoroutineDerive(derivers *deriver.Matcher) *GoroutineDerive {
-	return &GoroutineDerive{derivers: derivers}
+// NewGoroutineDerive creates a new GoroutineDerive checker reporting in the given style.
+// If explainMissing is true, closures calling only part of an AND group are
+// reported with the missing specs:
+//
+//	goroutine missing deriver newrelic.NewContext (NewGoroutine present)
+func NewGoroutineDerive(derivers *deriver.Matcher, style MessageStyle, explainMissing bool) *GoroutineDerive {
+	return &GoroutineDerive{derivers: derivers, style: style, explainMissing: explainMissing}
 }
 
 // Name returns the checker name for ignore directive matching.
@@ -135,10 +268,16 @@ func (c *GoroutineDerive) CheckGoStmt(cctx *probe.Context, stmt *ast.GoStmt) *in
 }
 
 func (c *GoroutineDerive) message() string {
+	if c.style == MessageStyleUnified {
+		return "go statement closure should call goroutine deriver " + c.derivers.Original
+	}
 	return "goroutine should call " + c.derivers.Original + " to derive context"
 }
 
 func (c *GoroutineDerive) deferMessage() string {
+	if c.style == MessageStyleUnified {
+		return "go statement closure should call goroutine deriver " + c.derivers.Original + " at start, not in defer"
+	}
 	return "goroutine calls " + c.derivers.Original + " in defer, but it should be called at goroutine start"
 }
 
@@ -159,10 +298,48 @@ func (c *GoroutineDerive) checkFromSSA(cctx *probe.Context, lit *ast.FuncLit) (*
 	}
 
 	if result.FoundOnlyInDefer {
-		return internal.FailWithDefer(c.message(), c.deferMessage()), true
+		return internal.FailWithDefer(c.message(), c.deferMessage()).WithConfidence(internal.ConfidenceMedium), true
+	}
+
+	related := deriverRelated(lit, result.Missing, result.Present)
+	if c.explainMissing && len(result.Missing) > 0 {
+		return internal.Fail(missingDeriverMessage(result.Missing, result.Present)).WithConfidence(internal.ConfidenceMedium).WithRelated(related...), true
+	}
+
+	return internal.Fail(c.message()).WithConfidence(internal.ConfidenceMedium).WithRelated(related...), true
+}
+
+// deriverRelated lists the missing and present specs of the closest AND
+// group as related information, one entry per spec, so that tools can
+// read them without parsing the message:
+//
+//	missing deriver newrelic.NewContext
+//	present deriver newrelic.Transaction.NewGoroutine
+func deriverRelated(lit *ast.FuncLit, missing, present []funcspec.Spec) []analysis.RelatedInformation {
+	if len(missing) == 0 {
+		return nil
+	}
+	related := make([]analysis.RelatedInformation, 0, len(missing)+len(present))
+	for _, spec := range missing {
+		related = append(related, analysis.RelatedInformation{Pos: lit.Pos(), Message: "missing deriver " + spec.FullName()})
 	}
+	for _, spec := range present {
+		related = append(related, analysis.RelatedInformation{Pos: lit.Pos(), Message: "present deriver " + spec.FullName()})
+	}
+	return related
+}
 
-	return internal.Fail(c.message()), true
+// missingDeriverMessage names the missing and present specs of an AND group.
+func missingDeriverMessage(missing, present []funcspec.Spec) string {
+	missingNames := make([]string, len(missing))
+	for i, spec := range missing {
+		missingNames[i] = spec.FullName()
+	}
+	presentNames := make([]string, len(present))
+	for i, spec := range present {
+		presentNames[i] = spec.FuncName
+	}
+	return "goroutine missing deriver " + strings.Join(missingNames, "+") + " (" + strings.Join(presentNames, ", ") + " present)"
 }
 
 func (c *GoroutineDerive) checkIdent(cctx *probe.Context, ident *ast.Ident) bool {
@@ -3,7 +3,10 @@ package checkers
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
+	"strconv"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 
@@ -12,6 +15,7 @@ import (
 	"github.com/mpyw/goroutinectx/internal/directive/ignore"
 	"github.com/mpyw/goroutinectx/internal/funcspec"
 	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
 )
 
 // gotaskConstructor defines how gotask tasks are created.
@@ -28,10 +32,13 @@ type taskConstructorConfig struct {
 	CallbackArgIdx int
 }
 
+const deriverFirstMessage = "goroutine deriver should be the first statement"
+
 // GotaskChecker checks gotask library API calls.
 type GotaskChecker struct {
-	derivers *deriver.Matcher
-	entries  []gotaskEntry
+	derivers     *deriver.Matcher
+	entries      []gotaskEntry
+	deriverFirst bool // Require the deriver call to be the first statement of task closures
 }
 
 // gotaskEntry defines a gotask API to check.
@@ -40,16 +47,23 @@ type gotaskEntry struct {
 	CallbackArgIdx int
 	Variadic       bool
 	IsDoAsync      bool
+	CtxArgIdx      int    // Argument holding the context of DoAsync-style calls
+	CtxBuilder     string // Builder method setting the context instead, e.g., "WithContext"
 }
 
 // NewGotaskChecker creates a gotask checker.
-func NewGotaskChecker(derivers *deriver.Matcher) *GotaskChecker {
+// With deriverFirst, task closures calling the deriver anywhere but in
+// their first statement are reported as well.
+// launchers is a comma-separated list of DoAsync-style APIs to check as
+// well; see parseAsyncLaunchers.
+func NewGotaskChecker(derivers *deriver.Matcher, deriverFirst bool, launchers string) *GotaskChecker {
 	if derivers == nil {
 		return nil
 	}
 
-	return &GotaskChecker{
-		derivers: derivers,
+	c := &GotaskChecker{
+		derivers:     derivers,
+		deriverFirst: deriverFirst,
 		entries: []gotaskEntry{
 			// DoAll variants
 			{Spec: funcspec.Spec{PkgPath: "github.com/siketyan/gotask", FuncName: "DoAll"}, CallbackArgIdx: 1, Variadic: true},
@@ -64,6 +78,40 @@ func NewGotaskChecker(derivers *deriver.Matcher) *GotaskChecker {
 			{Spec: funcspec.Spec{PkgPath: "github.com/siketyan/gotask", TypeName: "CancelableTask", FuncName: "DoAsync"}, CallbackArgIdx: 0, IsDoAsync: true},
 		},
 	}
+	c.entries = append(c.entries, parseAsyncLaunchers(launchers)...)
+	return c
+}
+
+// parseAsyncLaunchers parses "pkg/path.Type.Method:ctx" entries, where ctx
+// is either the index of the context argument, or the name of the builder
+// method supplying the context earlier in the receiver chain:
+//
+//	github.com/example/jobs.Job.Run:1              // job.Run(name, ctx)
+//	github.com/example/jobs.Job.Start:WithContext  // job.WithContext(ctx).Start()
+//
+// The context defaults to the first argument. Entries with a negative
+// index are skipped.
+func parseAsyncLaunchers(launchers string) []gotaskEntry {
+	var entries []gotaskEntry
+	for part := range strings.SplitSeq(launchers, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, ctxPos, _ := strings.Cut(part, ":")
+		entry := gotaskEntry{Spec: funcspec.Parse(name), IsDoAsync: true}
+		if n, err := strconv.Atoi(ctxPos); err == nil {
+			if n < 0 {
+				continue
+			}
+			entry.CtxArgIdx = n
+		} else {
+			entry.CtxBuilder = ctxPos
+		}
+		entries = append(entries, entry)
+	}
+	return entries
 }
 
 // Name returns the checker name.
@@ -90,8 +138,7 @@ func (c *GotaskChecker) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool
 	return false
 }
 
-// CheckCall checks the call expression.
-// Note: This checker may report multiple diagnostics directly to pass.
+// CheckCall checks the call expression. Variadic APIs fail once per failing argument.
 func (c *GotaskChecker) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
 	fn := funcspec.ExtractFunc(cctx.Pass, call)
 	if fn == nil {
@@ -103,26 +150,37 @@ func (c *GotaskChecker) CheckCall(cctx *probe.Context, call *ast.CallExpr) *inte
 			continue
 		}
 
+		if entry.IsDoAsync && entry.CtxBuilder != "" {
+			return c.checkBuilder(cctx, call, entry)
+		}
+
 		if entry.IsDoAsync {
-			return c.checkDoAsync(cctx, call, entry)
+			result := c.checkDoAsync(cctx, call, entry)
+			if result.OK && c.deriverFirst {
+				return c.checkDoAsyncDeriverFirst(cctx, call, entry)
+			}
+			return result
 		}
 
-		// For variadic APIs, we report each failing argument separately
-		c.checkVariadic(cctx, call, entry)
-		return internal.OK() // We handle reporting ourselves
+		// For variadic APIs, each failing argument fails separately
+		result := c.checkVariadic(cctx, call, entry)
+		if c.deriverFirst {
+			result = internal.Join(result, c.checkVariadicDeriverFirst(cctx, call, entry))
+		}
+		return result
 	}
 
 	return internal.OK()
 }
 
 func (c *GotaskChecker) checkDoAsync(cctx *probe.Context, call *ast.CallExpr, entry gotaskEntry) *internal.Result {
-	if len(call.Args) == 0 {
+	if entry.CtxArgIdx >= len(call.Args) {
 		return internal.OK()
 	}
 
-	ctxArg := call.Args[0]
+	ctxArg := call.Args[entry.CtxArgIdx]
 
-	// Check 1: Is the ctx argument (first arg) a deriver call?
+	// Check 1: Is the ctx argument a deriver call?
 	if c.argIsDeriverCall(cctx, ctxArg) {
 		return internal.OK()
 	}
@@ -133,19 +191,82 @@ func (c *GotaskChecker) checkDoAsync(cctx *probe.Context, call *ast.CallExpr, en
 	}
 
 	// Neither condition satisfied - report error with pointer receiver format
-	msg := formatMethodMessage(entry.Spec.FullName())
+	msg := formatMethodMessage(entry.Spec.FullName(), ordinal(entry.CtxArgIdx+1)+" argument")
 	return internal.Fail(msg)
 }
 
-// formatMethodMessage formats a method name with pointer receiver.
-// Input: "gotask.Task.DoAsync"
+// checkBuilder checks a launcher whose context is supplied by a builder
+// method earlier in its receiver chain, as in job.WithContext(ctx).Start().
+// The builder's context argument must be a deriver call. A chain traced to
+// its start without the builder is reported as well; chains that can't be
+// traced are not.
+func (c *GotaskChecker) checkBuilder(cctx *probe.Context, call *ast.CallExpr, entry gotaskEntry) *internal.Result {
+	ctxArg, traced := c.builderCtxArg(cctx, call, entry.CtxBuilder)
+	if !traced || (ctxArg != nil && c.argIsDeriverCall(cctx, ctxArg)) {
+		return internal.OK()
+	}
+
+	msg := formatMethodMessage(entry.Spec.FullName(), "context set by "+entry.CtxBuilder)
+	return internal.Fail(msg)
+}
+
+// builderCtxArg walks the receiver chain of call back to the builder method
+// and returns its context argument. traced is false if the chain reaches an
+// expression that can't be traced, such as a parameter.
+func (c *GotaskChecker) builderCtxArg(cctx *probe.Context, call *ast.CallExpr, builder string) (ctxArg ast.Expr, traced bool) {
+	visited := make(map[*ast.CallExpr]bool)
+	expr := getMethodReceiver(call)
+	for expr != nil {
+		switch e := ast.Unparen(expr).(type) {
+		case *ast.CallExpr:
+			if visited[e] {
+				return nil, false
+			}
+			visited[e] = true
+
+			sel, ok := e.Fun.(*ast.SelectorExpr)
+			if !ok || cctx.Pass.TypesInfo.Selections[sel] == nil {
+				return nil, true // Chain starts with a constructor
+			}
+			if sel.Sel.Name == builder {
+				return contextArg(cctx, e), true
+			}
+			expr = sel.X
+
+		case *ast.Ident:
+			assigned := cctx.CallExprAssignedToIdent(e)
+			if assigned == nil {
+				return nil, false
+			}
+			expr = assigned
+
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// contextArg returns the first context.Context argument of call, or nil.
+func contextArg(cctx *probe.Context, call *ast.CallExpr) ast.Expr {
+	for _, arg := range call.Args {
+		if typ := cctx.Pass.TypesInfo.TypeOf(arg); typ != nil && typeutil.IsContextType(typ) {
+			return arg
+		}
+	}
+	return nil
+}
+
+// formatMethodMessage formats a method name with pointer receiver and the
+// subject that should call the deriver.
+// Input: "gotask.Task.DoAsync", "1st argument"
 // Output: "gotask.(*Task).DoAsync() 1st argument should call goroutine deriver"
-func formatMethodMessage(apiName string) string {
+func formatMethodMessage(apiName, subject string) string {
 	parts := splitAPIName(apiName)
 	if len(parts) == 3 {
-		return parts[0] + ".(*" + parts[1] + ")." + parts[2] + "() 1st argument should call goroutine deriver"
+		return parts[0] + ".(*" + parts[1] + ")." + parts[2] + "() " + subject + " should call goroutine deriver"
 	}
-	return apiName + "() 1st argument should call goroutine deriver"
+	return apiName + "() " + subject + " should call goroutine deriver"
 }
 
 // splitAPIName splits an API name like "pkg.Type.Method" into parts.
@@ -163,15 +284,16 @@ func splitAPIName(name string) []string {
 	return parts
 }
 
-func (c *GotaskChecker) checkVariadic(cctx *probe.Context, call *ast.CallExpr, entry gotaskEntry) {
+func (c *GotaskChecker) checkVariadic(cctx *probe.Context, call *ast.CallExpr, entry gotaskEntry) *internal.Result {
 	startIdx := entry.CallbackArgIdx
 	if startIdx >= len(call.Args) {
-		return
+		return internal.OK()
 	}
 
 	// Check if this is a variadic expansion (e.g., DoAllFns(ctx, slice...))
 	isVariadicExpansion := call.Ellipsis.IsValid()
 
+	var results []*internal.Result
 	for i := startIdx; i < len(call.Args); i++ {
 		if !c.argCallsDeriver(cctx, call.Args[i], entry) {
 			var msg string
@@ -183,9 +305,91 @@ func (c *GotaskChecker) checkVariadic(cctx *probe.Context, call *ast.CallExpr, e
 				msg = fmt.Sprintf("%s() %s argument should call goroutine deriver",
 					entry.Spec.FullName(), ordinal(argNum))
 			}
-			cctx.Pass.Reportf(call.Pos(), "%s", msg)
+			results = append(results, internal.FailAt(call.Pos(), msg))
 		}
 	}
+	return internal.Join(results...)
+}
+
+// checkDoAsyncDeriverFirst fails the DoAsync call if the task closure
+// calls the deriver, but not as its first statement.
+// A deriver call passed as the ctx argument needs nothing from the closure.
+func (c *GotaskChecker) checkDoAsyncDeriverFirst(cctx *probe.Context, call *ast.CallExpr, entry gotaskEntry) *internal.Result {
+	if entry.CtxArgIdx >= len(call.Args) || c.argIsDeriverCall(cctx, call.Args[entry.CtxArgIdx]) {
+		return internal.OK()
+	}
+
+	taskExpr := getMethodReceiver(call)
+	if taskExpr == nil {
+		return internal.OK()
+	}
+	constructorCall := c.findConstructorCall(cctx, taskExpr)
+	if constructorCall == nil || gotaskConstructor.CallbackArgIdx >= len(constructorCall.Args) {
+		return internal.OK()
+	}
+
+	return c.checkDeriverFirst(cctx, call, constructorCall.Args[gotaskConstructor.CallbackArgIdx])
+}
+
+// checkVariadicDeriverFirst fails the call once per task closure that
+// calls the deriver, but not as its first statement.
+func (c *GotaskChecker) checkVariadicDeriverFirst(cctx *probe.Context, call *ast.CallExpr, entry gotaskEntry) *internal.Result {
+	var results []*internal.Result
+	for i := entry.CallbackArgIdx; i < len(call.Args); i++ {
+		results = append(results, c.checkDeriverFirst(cctx, call, call.Args[i]))
+	}
+	return internal.Join(results...)
+}
+
+// checkDeriverFirst fails the call if the task closure held by arg
+// calls the deriver, but not in its first statement.
+// Closures without any deriver call are left to the regular check.
+func (c *GotaskChecker) checkDeriverFirst(cctx *probe.Context, call *ast.CallExpr, arg ast.Expr) *internal.Result {
+	lit := c.taskClosureOf(cctx, arg)
+	if lit == nil || len(lit.Body.List) == 0 {
+		return internal.OK()
+	}
+	if !c.derivers.SatisfiesAnyGroup(cctx.Pass, lit.Body) || c.stmtCallsDeriver(cctx, lit.Body.List[0]) {
+		return internal.OK()
+	}
+
+	return internal.FailAt(call.Pos(), deriverFirstMessage)
+}
+
+// stmtCallsDeriver checks if a simple statement calls the deriver.
+// Compound statements such as if or for don't count, since their
+// deriver calls may not run.
+func (c *GotaskChecker) stmtCallsDeriver(cctx *probe.Context, stmt ast.Stmt) bool {
+	switch stmt.(type) {
+	case *ast.ExprStmt, *ast.AssignStmt, *ast.DeclStmt:
+		return c.derivers.SatisfiesAnyGroup(cctx.Pass, stmt)
+	}
+	return false
+}
+
+// taskClosureOf resolves a task argument to its closure: a func literal,
+// the callback of a task constructor call, or a variable holding either.
+// Returns nil if the closure can't be traced.
+func (c *GotaskChecker) taskClosureOf(cctx *probe.Context, expr ast.Expr) *ast.FuncLit {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.FuncLit:
+		return e
+
+	case *ast.CallExpr:
+		if c.isTaskConstructorCall(cctx, e) && gotaskConstructor.CallbackArgIdx < len(e.Args) {
+			return c.taskClosureOf(cctx, e.Args[gotaskConstructor.CallbackArgIdx])
+		}
+
+	case *ast.Ident:
+		if lit := cctx.FuncLitOfIdent(e); lit != nil {
+			return lit
+		}
+		if call := cctx.CallExprAssignedToIdent(e); call != nil && c.isTaskConstructorCall(cctx, call) {
+			return c.taskClosureOf(cctx, call)
+		}
+	}
+
+	return nil
 }
 
 // argIsDeriverCall checks if the argument expression IS a call to the deriver.
@@ -379,6 +583,9 @@ func (c *GotaskChecker) checkIdent(cctx *probe.Context, ident *ast.Ident) bool {
 
 	// Check if this is a slice type - we can't trace slice contents
 	if _, isSlice := v.Type().Underlying().(*types.Slice); isSlice {
+		if sliceFromOpaqueCall(cctx, v, ident.Pos()) {
+			return true // Returned by a function we don't trace, assume OK
+		}
 		return false // Can't trace slice contents, report error
 	}
 
@@ -398,6 +605,72 @@ func (c *GotaskChecker) checkIdent(cctx *probe.Context, ident *ast.Ident) bool {
 	return true
 }
 
+// sliceFromOpaqueCall checks if a slice variable is assigned the result of
+// a function call before the given position, as in
+// "tasks := collectTasks(ctx)" or "var tasks = collectTasks(ctx)". Such
+// slices are built out of sight, unlike literals and append calls in the
+// same function. Package-level variables are initialized before any use,
+// so their declaration counts wherever it appears.
+func sliceFromOpaqueCall(cctx *probe.Context, v *types.Var, beforePos token.Pos) bool {
+	f := cctx.FileOf(v.Pos())
+	if f == nil {
+		return false
+	}
+	pkgLevel := v.Pkg() != nil && v.Parent() == v.Pkg().Scope()
+
+	found := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if n == nil || (n.Pos() >= beforePos && !pkgLevel) {
+			return true
+		}
+		var lhs []*ast.Ident
+		var rhs []ast.Expr
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, expr := range n.Lhs {
+				ident, _ := expr.(*ast.Ident)
+				lhs = append(lhs, ident)
+			}
+			rhs = n.Rhs
+		case *ast.ValueSpec:
+			lhs, rhs = n.Names, n.Values
+		default:
+			return true
+		}
+		if len(rhs) == 0 {
+			return true
+		}
+		for i, ident := range lhs {
+			if ident == nil || cctx.Pass.TypesInfo.ObjectOf(ident) != v {
+				continue
+			}
+			value := rhs[0]
+			if len(lhs) == len(rhs) {
+				value = rhs[i]
+			}
+			if call, ok := ast.Unparen(value).(*ast.CallExpr); ok && !isBuiltinCall(cctx, call) {
+				found = true
+			}
+		}
+		return true
+	})
+
+	return found
+}
+
+// isBuiltinCall checks if the call is to a builtin such as append or make.
+func isBuiltinCall(cctx *probe.Context, call *ast.CallExpr) bool {
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = cctx.Pass.TypesInfo.Uses[ident].(*types.Builtin)
+	return ok
+}
+
 // checkCallExpr checks if a call expression contains a deriver.
 func (c *GotaskChecker) checkCallExpr(cctx *probe.Context, call *ast.CallExpr) bool {
 	// Case 1: Task constructor (e.g., NewTask(fn)) - check fn
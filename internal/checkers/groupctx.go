@@ -0,0 +1,51 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// errgroupWithContext returns the group and the context canceled when a goroutine fails.
+var errgroupWithContext = funcspec.Spec{PkgPath: "golang.org/x/sync/errgroup", FuncName: "WithContext"}
+
+// groupCtxResultIdx is the result index of the derived context.
+const groupCtxResultIdx = 1
+
+// UnusedGroupCtx reports errgroup.WithContext calls whose returned context
+// is never used. Without it, goroutines are not canceled when one fails,
+// which defeats the purpose of WithContext (usually a copy-paste bug).
+type UnusedGroupCtx struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*UnusedGroupCtx) Name() ignore.CheckerName {
+	return ignore.GroupCtx
+}
+
+// MatchCall returns true if the call is errgroup.WithContext.
+func (*UnusedGroupCtx) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	return fn != nil && errgroupWithContext.Matches(fn)
+}
+
+// CheckCall checks that the returned context has any use (SSA-based).
+func (*UnusedGroupCtx) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if cctx.SSAProg == nil || cctx.Tracer == nil {
+		return internal.OK()
+	}
+
+	ssaCall := cctx.SSAProg.CallAt(call)
+	if ssaCall == nil {
+		return internal.OK() // Can't analyze, assume OK
+	}
+
+	if cctx.Tracer.ResultUnused(ssaCall, groupCtxResultIdx) {
+		return internal.Fail("errgroup group context is never used").WithConfidence(internal.ConfidenceMedium)
+	}
+	return internal.OK()
+}
@@ -0,0 +1,75 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+const ctxlessHandlerMapMessage = "dispatch map handler should accept context.Context"
+
+// CtxlessHandlerMap reports func literals registered in a dispatch map whose
+// handler type takes no context, where a context is in scope but the handler
+// doesn't use it:
+//
+//	handlers := map[string]func() error{
+//	    "sync": func() error { return doSync() },  // reported
+//	    "ping": func() error { return ping(ctx) }, // OK
+//	}
+//
+// Handlers should take context.Context, as in map[string]func(context.Context) error.
+type CtxlessHandlerMap struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*CtxlessHandlerMap) Name() ignore.CheckerName {
+	return ignore.HandlerMap
+}
+
+// NodeTypes returns the node types this checker inspects.
+func (*CtxlessHandlerMap) NodeTypes() []ast.Node {
+	return []ast.Node{(*ast.KeyValueExpr)(nil)}
+}
+
+// CheckNode checks func literals stored as map values.
+func (*CtxlessHandlerMap) CheckNode(cctx *probe.Context, node ast.Node) *internal.Result {
+	kv, ok := node.(*ast.KeyValueExpr)
+	if !ok || len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	lit, ok := ast.Unparen(kv.Value).(*ast.FuncLit)
+	if !ok || !isMapElement(cctx, kv) {
+		return internal.OK()
+	}
+
+	if cctx.FuncTypeHasContextParam(lit.Type) || cctx.FuncLitUsesContext(lit) {
+		return internal.OK()
+	}
+
+	return internal.Fail(ctxlessHandlerMapMessage)
+}
+
+// isMapElement checks if the key-value pair is an element of a map composite literal.
+func isMapElement(cctx *probe.Context, kv *ast.KeyValueExpr) bool {
+	file := cctx.FileOf(kv.Pos())
+	if file == nil {
+		return false
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, kv.Pos(), kv.End())
+	if len(path) < 2 || path[0] != kv {
+		return false
+	}
+	compLit, ok := path[1].(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+
+	_, isMap := cctx.Pass.TypesInfo.TypeOf(compLit).Underlying().(*types.Map)
+	return isMap
+}
@@ -0,0 +1,74 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const ignoredCtxErrMessage = "ctx.Err() result is ignored"
+
+// IgnoredCtxErr reports ctx.Err() calls whose result is discarded:
+//
+//	_ = ctx.Err()
+//	ctx.Err()
+//
+// The caller checked for cancellation but carries on regardless.
+type IgnoredCtxErr struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*IgnoredCtxErr) Name() ignore.CheckerName {
+	return ignore.IgnoredCtxErr
+}
+
+// NodeTypes returns the node types this checker inspects.
+func (*IgnoredCtxErr) NodeTypes() []ast.Node {
+	return []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+}
+
+// CheckNode checks "_ = ctx.Err()" and "ctx.Err()" statements.
+func (*IgnoredCtxErr) CheckNode(cctx *probe.Context, node ast.Node) *internal.Result {
+	var expr ast.Expr
+
+	switch n := node.(type) {
+	case *ast.AssignStmt:
+		if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+			return internal.OK()
+		}
+		lhs, ok := n.Lhs[0].(*ast.Ident)
+		if !ok || lhs.Name != "_" {
+			return internal.OK()
+		}
+		expr = n.Rhs[0]
+
+	case *ast.ExprStmt:
+		expr = n.X
+
+	default:
+		return internal.OK()
+	}
+
+	if isCtxErrCall(cctx, expr) {
+		return internal.Fail(ignoredCtxErrMessage)
+	}
+	return internal.OK()
+}
+
+// isCtxErrCall checks if the expression is an Err() call on a context.Context.
+func isCtxErrCall(cctx *probe.Context, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Err" {
+		return false
+	}
+	return typeutil.IsContextType(cctx.Pass.TypesInfo.TypeOf(sel.X))
+}
@@ -0,0 +1,78 @@
+package initgoroutine
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+)
+
+const checkerName = ignore.InitGoroutine
+
+// Checker reports go statements executed during package initialization.
+type Checker struct{}
+
+// New creates a new initgoroutine checker.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Check runs the initgoroutine analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.WithStack([]ast.Node{(*ast.GoStmt)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return true
+		}
+
+		if !runsAtInit(stack) {
+			return true
+		}
+
+		line := pass.Fset.Position(n.Pos()).Line
+		if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+			return true
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      n.Pos(),
+			Category: string(checkerName),
+			Message:  "goroutine spawned during package initialization cannot be canceled",
+		})
+		return true
+	})
+}
+
+// runsAtInit checks if the node at the top of the stack executes during
+// package initialization: inside an init function or a package-level
+// variable initializer, through immediately invoked func literals only.
+func runsAtInit(stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl:
+			return n.Recv == nil && n.Name.Name == "init"
+
+		case *ast.FuncLit:
+			if i == 0 {
+				return false
+			}
+			call, ok := stack[i-1].(*ast.CallExpr)
+			if !ok || ast.Unparen(call.Fun) != n {
+				return false // Called later, if at all
+			}
+
+		case *ast.ValueSpec:
+			// Package-level specs are nested as File > GenDecl > ValueSpec
+			if i == 2 {
+				return true
+			}
+		}
+	}
+	return false
+}
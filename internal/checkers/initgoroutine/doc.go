@@ -0,0 +1,34 @@
+// Package initgoroutine reports goroutines spawned during package initialization.
+//
+// # Overview
+//
+// A goroutine started from an init function or a package-level variable
+// initializer runs for the lifetime of the process. No context exists yet,
+// so it can never be canceled, and such goroutines are often unintended
+// side effects of importing a package:
+//
+//	func init() {
+//	    go refreshLoop() // Warning
+//	}
+//
+//	var _ = func() bool {
+//	    go backgroundInit() // Warning
+//	    return true
+//	}()
+//
+// Only code that runs during initialization is reported: function literals
+// are followed only when they are invoked immediately. A goroutine inside a
+// handler registered from init runs later and is not reported:
+//
+//	func init() {
+//	    http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+//	        go audit(r) // OK: runs per request
+//	    })
+//	}
+//
+// # Why Separate?
+//
+// Initialization code has no context.Context in scope, so the main runner
+// never visits it. This checker therefore walks every go statement in the
+// package.
+package initgoroutine
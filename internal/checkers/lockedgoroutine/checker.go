@@ -0,0 +1,194 @@
+package lockedgoroutine
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const checkerName = ignore.LockedGoroutine
+
+// waitFuncs are the methods waiting for spawned goroutines to finish.
+var waitFuncs = []funcspec.Spec{
+	funcspec.Parse("sync.WaitGroup.Wait"),
+	funcspec.Parse("golang.org/x/sync/errgroup.Group.Wait"),
+	funcspec.Parse("github.com/sourcegraph/conc.WaitGroup.Wait"),
+}
+
+// Checker reports goroutines spawned and awaited while a lock is held.
+type Checker struct{}
+
+// New creates a new lockedgoroutine checker.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Check runs the lockedgoroutine analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.Preorder([]ast.Node{(*ast.BlockStmt)(nil)}, func(n ast.Node) {
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return
+		}
+
+		for _, stmt := range awaitedUnderLock(pass, n.(*ast.BlockStmt).List) {
+			line := pass.Fset.Position(stmt.Pos()).Line
+			if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+				continue
+			}
+
+			pass.Report(analysis.Diagnostic{
+				Pos:      stmt.Pos(),
+				Category: string(checkerName),
+				Message:  "goroutine awaited while holding lock may deadlock",
+			})
+		}
+	})
+}
+
+// awaitedUnderLock returns the go statements of list spawned while a lock
+// is held and awaited by a later statement before the lock is released.
+// A deferred unlock holds the lock until the end of the block.
+func awaitedUnderLock(pass *analysis.Pass, list []ast.Stmt) []*ast.GoStmt {
+	held := make(map[string]bool) // Locked expressions, e.g., "s.mu"
+	var spawned, awaited []*ast.GoStmt
+
+	for _, stmt := range list {
+		switch stmt := stmt.(type) {
+		case *ast.ExprStmt:
+			if key, locks, ok := lockCall(pass, stmt.X); ok {
+				if locks {
+					held[key] = true
+					continue
+				}
+				delete(held, key)
+				if len(held) == 0 {
+					spawned = nil // Released before being awaited
+				}
+				continue
+			}
+
+		case *ast.GoStmt:
+			if len(held) > 0 {
+				spawned = append(spawned, stmt)
+			}
+			continue
+		}
+
+		if len(spawned) > 0 && awaits(pass, stmt) {
+			awaited = append(awaited, spawned...)
+			spawned = nil
+		}
+	}
+	return awaited
+}
+
+// lockCall checks if expr locks (locks is true) or unlocks a sync.Mutex or
+// sync.RWMutex, and returns the locked expression as key.
+func lockCall(pass *analysis.Pass, expr ast.Expr) (key string, locks, ok bool) {
+	call, isCall := ast.Unparen(expr).(*ast.CallExpr)
+	if !isCall {
+		return "", false, false
+	}
+	sel, isSel := call.Fun.(*ast.SelectorExpr)
+	if !isSel {
+		return "", false, false
+	}
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "sync" {
+		return "", false, false
+	}
+
+	switch fn.Name() {
+	case "Lock", "RLock":
+		return types.ExprString(sel.X), true, true
+	case "Unlock", "RUnlock":
+		return types.ExprString(sel.X), false, true
+	}
+	return "", false, false
+}
+
+// awaits checks if stmt waits for goroutines: a Wait method of a group, a
+// channel receive or a range over a channel. A select with a ctx.Done()
+// or default case does not wait unboundedly. Func literals are skipped.
+func awaits(pass *analysis.Pass, stmt ast.Stmt) bool {
+	found := false
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.SelectStmt:
+			return !guardedSelect(pass, n)
+
+		case *ast.CallExpr:
+			if fn := funcspec.ExtractFunc(pass, n); fn != nil && isWaitFunc(fn) {
+				found = true
+			}
+
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW && !isCtxDoneCall(pass, n.X) {
+				found = true
+			}
+
+		case *ast.RangeStmt:
+			if t := pass.TypesInfo.TypeOf(n.X); t != nil {
+				if _, ok := t.Underlying().(*types.Chan); ok {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// isWaitFunc checks if fn is one of waitFuncs.
+func isWaitFunc(fn *types.Func) bool {
+	for i := range waitFuncs {
+		if waitFuncs[i].Matches(fn) {
+			return true
+		}
+	}
+	return false
+}
+
+// guardedSelect checks if the select has a ctx.Done() or default case.
+func guardedSelect(pass *analysis.Pass, sel *ast.SelectStmt) bool {
+	for _, stmt := range sel.Body.List {
+		comm := stmt.(*ast.CommClause).Comm
+		if comm == nil {
+			return true
+		}
+		if expr, ok := comm.(*ast.ExprStmt); ok {
+			if recv, ok := ast.Unparen(expr.X).(*ast.UnaryExpr); ok && recv.Op == token.ARROW && isCtxDoneCall(pass, recv.X) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isCtxDoneCall checks for a ctx.Done() call on a context.Context.
+func isCtxDoneCall(pass *analysis.Pass, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Done" {
+		return false
+	}
+	typ := pass.TypesInfo.TypeOf(sel.X)
+	return typ != nil && typeutil.IsContextType(typ)
+}
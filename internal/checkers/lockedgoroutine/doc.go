@@ -0,0 +1,35 @@
+// Package lockedgoroutine reports goroutines spawned and awaited while a
+// lock is held.
+//
+// # Overview
+//
+// A goroutine spawned while a mutex is held and then waited for before the
+// mutex is released deadlocks as soon as the goroutine needs the same lock,
+// directly or through a callee:
+//
+//	mu.Lock()
+//	go refresh(&wg) // Warning
+//	wg.Wait()
+//	mu.Unlock()
+//
+// Only the structural pattern is reported: a go statement between Lock
+// (or RLock) and the matching Unlock in the same block, or after Lock with
+// a deferred Unlock, followed in that block by a wait before the unlock.
+// Waits are the Wait methods of sync.WaitGroup, errgroup.Group and
+// conc.WaitGroup, channel receives and ranges over channels. A select
+// with a ctx.Done() or default case is not a wait, since a context
+// timeout bounds it:
+//
+//	mu.Lock()
+//	go compute(done)
+//	select {
+//	case <-done:
+//	case <-ctx.Done(): // OK: bounded by the context
+//	}
+//	mu.Unlock()
+//
+// # Why Separate?
+//
+// The deadlock does not depend on a context being in scope, so this
+// checker walks every block rather than only context-aware functions.
+package lockedgoroutine
@@ -0,0 +1,94 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// rootContexts create a fresh context detached from any caller.
+var rootContexts = []funcspec.Spec{
+	{PkgPath: "context", FuncName: "Background"},
+	{PkgPath: "context", FuncName: "TODO"},
+}
+
+// LoopBackground reports context.Background() and context.TODO() calls in
+// loop bodies where a context is in scope. Each iteration drops the
+// caller's context instead of sharing it:
+//
+//	for _, x := range xs {
+//	    ctx := context.Background() // use the outer ctx
+//	    process(ctx, x)
+//	}
+type LoopBackground struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*LoopBackground) Name() ignore.CheckerName {
+	return ignore.LoopBackground
+}
+
+// MatchCall returns true if the call is context.Background or context.TODO.
+func (*LoopBackground) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	return rootContextFunc(pass, call) != ""
+}
+
+// CheckCall reports the call if it is inside a loop body of the current function.
+func (*LoopBackground) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	file := cctx.FileOf(call.Pos())
+	if file == nil {
+		return internal.OK()
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, call.Pos(), call.End())
+	if !inLoopBody(path) {
+		return internal.OK()
+	}
+
+	return internal.Fail(fmt.Sprintf("recreating context.%s() in loop; use %q", rootContextFunc(cctx.Pass, call), cctx.CtxNames[0]))
+}
+
+// rootContextFunc returns "Background" or "TODO" for the matching call, or "".
+func rootContextFunc(pass *analysis.Pass, call *ast.CallExpr) string {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return ""
+	}
+	for _, spec := range rootContexts {
+		if spec.Matches(fn) {
+			return spec.FuncName
+		}
+	}
+	return ""
+}
+
+// inLoopBody checks if the innermost function in the path evaluates the node
+// once per iteration of a for or range loop. Loop headers such as
+// "for ctx := context.Background(); ..." are evaluated once and not counted.
+func inLoopBody(path []ast.Node) bool {
+	for i, node := range path {
+		switch n := node.(type) {
+		case *ast.FuncLit, *ast.FuncDecl:
+			return false
+		case *ast.ForStmt:
+			if i > 0 && path[i-1] == n.Body {
+				return true
+			}
+		case *ast.RangeStmt:
+			if i > 0 && path[i-1] == n.Body {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package checkers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// MessageStyle selects the wording of go statement diagnostics.
+type MessageStyle string
+
+// Message styles.
+const (
+	// MessageStyleLegacy keeps the original wording:
+	//
+	//	goroutine does not propagate context "ctx"
+	MessageStyleLegacy MessageStyle = "legacy"
+
+	// MessageStyleUnified names the construct like the other checkers:
+	//
+	//	go statement closure should use context "ctx"
+	MessageStyleUnified MessageStyle = "unified"
+)
+
+// ErrInvalidMessageStyle is returned for an unknown message style.
+var ErrInvalidMessageStyle = errors.New("invalid message style")
+
+// ParseMessageStyle parses a message style name.
+func ParseMessageStyle(s string) (MessageStyle, error) {
+	switch style := MessageStyle(s); style {
+	case MessageStyleLegacy, MessageStyleUnified:
+		return style, nil
+	}
+	return "", fmt.Errorf("%w: %q (want %q or %q)", ErrInvalidMessageStyle, s, MessageStyleLegacy, MessageStyleUnified)
+}
+
+// contextsPhrase names the context a closure should use:
+//
+//	context "ctx"
+//
+// With verbose messages and several contexts in scope, all of them are
+// named after the quantifier:
+//
+//	one of contexts ["ctx1" "ctx2"]
+func contextsPhrase(cctx *probe.Context, quantifier string) string {
+	if cctx.VerboseMessages && len(cctx.CtxNames) > 1 {
+		return fmt.Sprintf("%s contexts %q", quantifier, cctx.CtxNames)
+	}
+	ctxName := "ctx"
+	if len(cctx.CtxNames) > 0 {
+		ctxName = cctx.CtxNames[0]
+	}
+	return fmt.Sprintf("context %q", ctxName)
+}
@@ -0,0 +1,161 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const (
+	nilCtxAssignMessage  = "context.Context should not be assigned nil"
+	nilCtxCompareMessage = "comparing context.Context to nil is usually a bug"
+)
+
+// NilCtx reports context.Context variables assigned or compared to nil.
+// A context should never be nil; callers pass context.Background() or
+// context.TODO() instead.
+type NilCtx struct {
+	allowGuard bool
+
+	// guards holds the conditions of allowed nil guards seen so far.
+	// The enclosing IfStmt is visited before its condition.
+	guards map[*ast.BinaryExpr]bool
+}
+
+// NewNilCtx creates a nil context checker.
+// If allowGuard is true, the defaulting guard is not reported:
+//
+//	if ctx == nil {
+//	    ctx = context.Background()
+//	}
+func NewNilCtx(allowGuard bool) *NilCtx {
+	return &NilCtx{
+		allowGuard: allowGuard,
+		guards:     make(map[*ast.BinaryExpr]bool),
+	}
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*NilCtx) Name() ignore.CheckerName {
+	return ignore.NilCtx
+}
+
+// NodeTypes returns the node types this checker inspects.
+func (*NilCtx) NodeTypes() []ast.Node {
+	return []ast.Node{
+		(*ast.IfStmt)(nil),
+		(*ast.BinaryExpr)(nil),
+		(*ast.AssignStmt)(nil),
+		(*ast.ValueSpec)(nil),
+	}
+}
+
+// CheckNode checks assignments and comparisons of contexts to nil.
+func (c *NilCtx) CheckNode(cctx *probe.Context, node ast.Node) *internal.Result {
+	switch n := node.(type) {
+	case *ast.IfStmt:
+		if c.allowGuard {
+			if cond := c.nilGuardCond(cctx, n); cond != nil {
+				c.guards[cond] = true
+			}
+		}
+
+	case *ast.BinaryExpr:
+		if c.guards[n] {
+			return internal.OK()
+		}
+		if c.comparesCtxToNil(cctx, n) {
+			return internal.Fail(nilCtxCompareMessage).WithConfidence(internal.ConfidenceLow)
+		}
+
+	case *ast.AssignStmt:
+		if n.Tok != token.ASSIGN || len(n.Lhs) != len(n.Rhs) {
+			return internal.OK()
+		}
+		for i := range n.Lhs {
+			if c.isCtxExpr(cctx, n.Lhs[i]) && isNil(cctx, n.Rhs[i]) {
+				return internal.Fail(nilCtxAssignMessage)
+			}
+		}
+
+	case *ast.ValueSpec:
+		for i, name := range n.Names {
+			if i < len(n.Values) && c.isCtxExpr(cctx, name) && isNil(cctx, n.Values[i]) {
+				return internal.Fail(nilCtxAssignMessage)
+			}
+		}
+	}
+
+	return internal.OK()
+}
+
+// nilGuardCond returns the condition of "if ctx == nil { ctx = ... }"
+// where the body assigns a non-nil value to the compared context.
+func (c *NilCtx) nilGuardCond(cctx *probe.Context, stmt *ast.IfStmt) *ast.BinaryExpr {
+	cond, ok := stmt.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.EQL || !c.comparesCtxToNil(cctx, cond) {
+		return nil
+	}
+
+	ctxExpr := cond.X
+	if isNil(cctx, ctxExpr) {
+		ctxExpr = cond.Y
+	}
+	ident, ok := ast.Unparen(ctxExpr).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := cctx.Pass.TypesInfo.ObjectOf(ident)
+
+	for _, s := range stmt.Body.List {
+		assign, ok := s.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != len(assign.Rhs) {
+			continue
+		}
+		for i, lhs := range assign.Lhs {
+			lhsIdent, ok := lhs.(*ast.Ident)
+			if ok && cctx.Pass.TypesInfo.ObjectOf(lhsIdent) == obj && !isNil(cctx, assign.Rhs[i]) {
+				return cond
+			}
+		}
+	}
+
+	return nil
+}
+
+// comparesCtxToNil checks for ctx == nil, nil == ctx, ctx != nil and nil != ctx.
+func (c *NilCtx) comparesCtxToNil(cctx *probe.Context, expr *ast.BinaryExpr) bool {
+	if expr.Op != token.EQL && expr.Op != token.NEQ {
+		return false
+	}
+	return (c.isCtxExpr(cctx, expr.X) && isNil(cctx, expr.Y)) ||
+		(isNil(cctx, expr.X) && c.isCtxExpr(cctx, expr.Y))
+}
+
+// isCtxExpr checks if the expression is a context.Context identifier.
+// Pointers to contexts are excluded since they may legitimately be nil.
+func (*NilCtx) isCtxExpr(cctx *probe.Context, expr ast.Expr) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj := cctx.Pass.TypesInfo.ObjectOf(ident)
+	if obj == nil {
+		return false
+	}
+	if _, isPtr := obj.Type().(*types.Pointer); isPtr {
+		return false
+	}
+	return typeutil.IsContextType(obj.Type())
+}
+
+// isNil checks if the expression is the predeclared nil.
+func isNil(cctx *probe.Context, expr ast.Expr) bool {
+	tv, ok := cctx.Pass.TypesInfo.Types[expr]
+	return ok && tv.IsNil()
+}
@@ -0,0 +1,150 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+const printCtxMessage = "printing context.Context directly may leak internal values"
+
+// fmtPrintFunc describes the arguments of a fmt print function.
+type fmtPrintFunc struct {
+	format   int // Index of the format argument, or -1 if every operand uses %v
+	operands int // Index of the first operand
+}
+
+// fmtPrintFuncs lists the fmt functions that format their operands.
+var fmtPrintFuncs = map[string]fmtPrintFunc{
+	"Print":    {format: -1, operands: 0},
+	"Println":  {format: -1, operands: 0},
+	"Sprint":   {format: -1, operands: 0},
+	"Sprintln": {format: -1, operands: 0},
+	"Fprint":   {format: -1, operands: 1},
+	"Fprintln": {format: -1, operands: 1},
+	"Printf":   {format: 0, operands: 1},
+	"Sprintf":  {format: 0, operands: 1},
+	"Errorf":   {format: 0, operands: 1},
+	"Fprintf":  {format: 1, operands: 2},
+}
+
+// PrintCtx reports context.Context values formatted by fmt functions.
+// A context prints its whole chain, including values stored with
+// context.WithValue, which is almost never intended:
+//
+//	fmt.Println(ctx)              // reported
+//	fmt.Printf("%v\n", ctx)       // reported
+//	fmt.Println(ctx.Value(key))   // OK
+type PrintCtx struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*PrintCtx) Name() ignore.CheckerName {
+	return ignore.PrintCtx
+}
+
+// MatchCall returns true if the call is one of the fmt print functions.
+func (*PrintCtx) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	_, ok := fmtPrintFuncOf(pass, call)
+	return ok
+}
+
+// CheckCall reports the call if a context is formatted with %v.
+func (*PrintCtx) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	spec, ok := fmtPrintFuncOf(cctx.Pass, call)
+	if !ok {
+		return internal.OK()
+	}
+
+	for _, arg := range printedWithV(cctx.Pass, call, spec) {
+		if typeutil.IsContextType(cctx.Pass.TypesInfo.TypeOf(arg)) {
+			return internal.Fail(printCtxMessage)
+		}
+	}
+	return internal.OK()
+}
+
+// fmtPrintFuncOf returns the argument layout of a fmt print call.
+func fmtPrintFuncOf(pass *analysis.Pass, call *ast.CallExpr) (fmtPrintFunc, bool) {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+		return fmtPrintFunc{}, false
+	}
+	spec, ok := fmtPrintFuncs[fn.Name()]
+	return spec, ok
+}
+
+// printedWithV returns the operands formatted with the %v verb.
+// For the Print family every operand qualifies; for the Printf family
+// the format must be a constant without explicit argument indexes.
+func printedWithV(pass *analysis.Pass, call *ast.CallExpr, spec fmtPrintFunc) []ast.Expr {
+	if spec.operands > len(call.Args) || call.Ellipsis.IsValid() {
+		return nil
+	}
+	operands := call.Args[spec.operands:]
+	if spec.format < 0 {
+		return operands
+	}
+
+	tv, ok := pass.TypesInfo.Types[call.Args[spec.format]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return nil
+	}
+
+	var result []ast.Expr
+	for _, i := range vVerbOperands(constant.StringVal(tv.Value)) {
+		if i < len(operands) {
+			result = append(result, operands[i])
+		}
+	}
+	return result
+}
+
+// vVerbOperands returns the operand indexes consumed by %v verbs in format.
+// Formats using explicit argument indexes such as %[1]v are skipped.
+func vVerbOperands(format string) []int {
+	var result []int
+	operand := 0
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+
+		// Flags
+		for i < len(format) && (format[i] == '+' || format[i] == '-' || format[i] == '#' || format[i] == ' ' || format[i] == '0') {
+			i++
+		}
+		// Width and precision, where '*' consumes an operand
+		for i < len(format) && (format[i] == '.' || format[i] == '*' || (format[i] >= '0' && format[i] <= '9')) {
+			if format[i] == '*' {
+				operand++
+			}
+			i++
+		}
+		if i >= len(format) {
+			break
+		}
+
+		switch format[i] {
+		case '%':
+			// Literal percent sign, no operand
+		case '[':
+			return nil
+		case 'v':
+			result = append(result, operand)
+			operand++
+		default:
+			operand++
+		}
+	}
+
+	return result
+}
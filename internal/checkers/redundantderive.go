@@ -0,0 +1,53 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/deriver"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// RedundantDerive reports goroutines that call the same deriver function
+// more than once on a path. This is harmless but usually a copy-paste error:
+//
+//	go func() {
+//	    ctx := apm.NewGoroutineContext(ctx)
+//	    ctx = apm.NewGoroutineContext(ctx) // redundant
+//	}()
+type RedundantDerive struct {
+	derivers *deriver.Matcher
+}
+
+// NewRedundantDerive creates a new RedundantDerive checker.
+func NewRedundantDerive(derivers *deriver.Matcher) *RedundantDerive {
+	return &RedundantDerive{derivers: derivers}
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*RedundantDerive) Name() ignore.CheckerName {
+	return ignore.RedundantDerive
+}
+
+// CheckGoStmt checks a go statement closure for repeated deriver calls (SSA-based).
+func (c *RedundantDerive) CheckGoStmt(cctx *probe.Context, stmt *ast.GoStmt) *internal.Result {
+	if cctx.SSAProg == nil || cctx.Tracer == nil {
+		return internal.OK()
+	}
+
+	lit, ok := stmt.Call.Fun.(*ast.FuncLit)
+	if !ok {
+		return internal.OK()
+	}
+
+	ssaFn := cctx.SSAProg.FindFuncLit(lit)
+	if ssaFn == nil {
+		return internal.OK() // Can't analyze, assume OK
+	}
+
+	if cctx.Tracer.ClosureDerivesTwice(ssaFn, c.derivers) {
+		return internal.Fail("goroutine derives context more than once").WithConfidence(internal.ConfidenceMedium)
+	}
+	return internal.OK()
+}
@@ -0,0 +1,131 @@
+package requestctx
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+)
+
+const checkerName = ignore.RequestCtx
+
+// requestMethods replace the context of an *http.Request.
+var requestMethods = []funcspec.Spec{
+	{PkgPath: "net/http", TypeName: "Request", FuncName: "WithContext"},
+	{PkgPath: "net/http", TypeName: "Request", FuncName: "Clone"},
+}
+
+// rootContexts create a context detached from the request.
+var rootContexts = []funcspec.Spec{
+	{PkgPath: "context", FuncName: "Background"},
+	{PkgPath: "context", FuncName: "TODO"},
+}
+
+// Checker reports request contexts replaced with context.Background() or context.TODO().
+type Checker struct{}
+
+// New creates a new requestctx checker.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Check runs the requestctx analysis on the given pass.
+func (c *Checker) Check(pass *analysis.Pass, insp *inspector.Inspector, ignoreMaps map[string]ignore.Map, skipFiles map[string]bool) {
+	insp.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if skipFiles[filename] {
+			return true
+		}
+
+		call := n.(*ast.CallExpr)
+		if !c.replacesParamRequestCtx(pass, call, stack) {
+			return true
+		}
+
+		line := pass.Fset.Position(call.Pos()).Line
+		if ignoreMaps[filename].ShouldIgnore(line, checkerName) {
+			return true
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      call.Pos(),
+			Category: string(checkerName),
+			Message:  "do not replace request context with Background in middleware",
+		})
+		return true
+	})
+}
+
+// replacesParamRequestCtx checks for r.WithContext(context.Background()) or
+// r.Clone(context.TODO()) where r is a parameter of an enclosing function.
+func (*Checker) replacesParamRequestCtx(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) bool {
+	if len(call.Args) != 1 || !matchesAny(funcspec.ExtractFunc(pass, call), requestMethods) {
+		return false
+	}
+
+	arg, ok := ast.Unparen(call.Args[0]).(*ast.CallExpr)
+	if !ok || !matchesAny(funcspec.ExtractFunc(pass, arg), rootContexts) {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	recv, ok := ast.Unparen(sel.X).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj, ok := pass.TypesInfo.ObjectOf(recv).(*types.Var)
+	if !ok {
+		return false
+	}
+
+	return isParamOfEnclosingFunc(pass, obj, stack)
+}
+
+// isParamOfEnclosingFunc checks if v is a parameter of any function on the stack.
+func isParamOfEnclosingFunc(pass *analysis.Pass, v *types.Var, stack []ast.Node) bool {
+	for _, node := range stack {
+		var ft *ast.FuncType
+		switch fn := node.(type) {
+		case *ast.FuncDecl:
+			ft = fn.Type
+		case *ast.FuncLit:
+			ft = fn.Type
+		default:
+			continue
+		}
+		if ft.Params == nil {
+			continue
+		}
+		for _, field := range ft.Params.List {
+			for _, name := range field.Names {
+				if pass.TypesInfo.Defs[name] == v {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func matchesAny(fn *types.Func, specs []funcspec.Spec) bool {
+	if fn == nil {
+		return false
+	}
+	for _, spec := range specs {
+		if spec.Matches(fn) {
+			return true
+		}
+	}
+	return false
+}
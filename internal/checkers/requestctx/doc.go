@@ -0,0 +1,24 @@
+// Package requestctx reports HTTP handlers that replace the request context.
+//
+// # Overview
+//
+// Middleware and handlers receive the request context through r.Context().
+// Replacing it with a fresh root context drops cancellation and request-scoped
+// values for everything downstream:
+//
+//	func middleware(next http.Handler) http.Handler {
+//	    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//	        next.ServeHTTP(w, r.WithContext(context.Background())) // Warning
+//	    })
+//	}
+//
+// Both r.WithContext and r.Clone are checked, with context.Background() or
+// context.TODO() as the argument, where r is a parameter of the enclosing
+// function.
+//
+// # Why Separate?
+//
+// Unlike the checkers run by the main runner, handlers usually have no
+// context.Context in scope; the request itself carries it. This checker
+// therefore walks every function rather than only context-aware ones.
+package requestctx
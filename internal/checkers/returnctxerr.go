@@ -0,0 +1,145 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// errgroupGoSpecs are the errgroup.Group methods whose callbacks return an error.
+var errgroupGoSpecs = []funcspec.Spec{
+	{PkgPath: "golang.org/x/sync/errgroup", TypeName: "Group", FuncName: "Go"},
+	{PkgPath: "golang.org/x/sync/errgroup", TypeName: "Group", FuncName: "TryGo"},
+}
+
+// ReturnCtxErr reports errgroup callbacks whose unbounded loop selects on
+// ctx.Done() but returns nil there. Returning ctx.Err() lets Wait report
+// the cancellation instead of a silent success.
+type ReturnCtxErr struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*ReturnCtxErr) Name() ignore.CheckerName {
+	return ignore.ReturnCtxErr
+}
+
+// MatchCall returns true if the call is errgroup.Group.Go or TryGo.
+func (*ReturnCtxErr) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return false
+	}
+	for _, spec := range errgroupGoSpecs {
+		if spec.Matches(fn) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCall checks the Done cases of select statements in unbounded loops.
+func (*ReturnCtxErr) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(call.Args) == 0 {
+		return internal.OK()
+	}
+
+	var lit *ast.FuncLit
+	switch arg := call.Args[0].(type) {
+	case *ast.FuncLit:
+		lit = arg
+	case *ast.Ident:
+		lit = cctx.FuncLitOfIdent(arg)
+	}
+	if lit == nil {
+		return internal.OK() // Can't analyze, assume OK
+	}
+
+	for _, clause := range doneClausesInUnboundedLoops(cctx, lit.Body) {
+		if returnsNil(cctx, clause) {
+			return internal.Fail("return ctx.Err() on cancellation in errgroup closure")
+		}
+	}
+	return internal.OK()
+}
+
+// doneClausesInUnboundedLoops collects "case <-ctx.Done():" clauses of select
+// statements inside "for { ... }" loops, without entering nested func literals.
+func doneClausesInUnboundedLoops(cctx *probe.Context, body *ast.BlockStmt) []*ast.CommClause {
+	var clauses []*ast.CommClause
+
+	inspectSkippingFuncLits(body, func(n ast.Node) {
+		loop, ok := n.(*ast.ForStmt)
+		if !ok || loop.Cond != nil {
+			return
+		}
+		inspectSkippingFuncLits(loop.Body, func(n ast.Node) {
+			clause, ok := n.(*ast.CommClause)
+			if ok && isCtxDoneRecv(cctx, clause.Comm) {
+				clauses = append(clauses, clause)
+			}
+		})
+	})
+
+	return clauses
+}
+
+// isCtxDoneRecv checks for a receive from ctx.Done() on a context.Context.
+func isCtxDoneRecv(cctx *probe.Context, comm ast.Stmt) bool {
+	stmt, ok := comm.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+	recv, ok := ast.Unparen(stmt.X).(*ast.UnaryExpr)
+	if !ok || recv.Op != token.ARROW {
+		return false
+	}
+	return isCtxDoneCall(cctx, recv.X)
+}
+
+// isCtxDoneCall checks for a ctx.Done() call on a context.Context.
+func isCtxDoneCall(cctx *probe.Context, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Done" {
+		return false
+	}
+	typ := cctx.Pass.TypesInfo.TypeOf(sel.X)
+	return typ != nil && typeutil.IsContextType(typ)
+}
+
+// returnsNil checks if the clause contains "return nil".
+func returnsNil(cctx *probe.Context, clause *ast.CommClause) bool {
+	found := false
+	for _, stmt := range clause.Body {
+		inspectSkippingFuncLits(stmt, func(n ast.Node) {
+			ret, ok := n.(*ast.ReturnStmt)
+			if ok && len(ret.Results) == 1 && isNil(cctx, ret.Results[0]) {
+				found = true
+			}
+		})
+	}
+	return found
+}
+
+// inspectSkippingFuncLits calls fn for every node under root except those
+// inside nested func literals.
+func inspectSkippingFuncLits(root ast.Node, fn func(ast.Node)) {
+	ast.Inspect(root, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		if n != nil {
+			fn(n)
+		}
+		return true
+	})
+}
@@ -0,0 +1,224 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// signalNotify is the channel-based signal API replaced by signal.NotifyContext.
+var signalNotify = funcspec.Spec{PkgPath: "os/signal", FuncName: "Notify"}
+
+// Signal reports signal.Notify calls where a context is in scope.
+// Cancellation should flow through the context via signal.NotifyContext
+// instead of an ad-hoc channel.
+type Signal struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*Signal) Name() ignore.CheckerName {
+	return ignore.Signal
+}
+
+// MatchCall returns true if the call is signal.Notify.
+func (*Signal) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	return fn != nil && signalNotify.Matches(fn)
+}
+
+// CheckCall reports the call with a fix rewriting it to signal.NotifyContext.
+func (c *Signal) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	ctxName := cctx.CtxNames[0]
+	msg := fmt.Sprintf("use signal.NotifyContext with context %q instead of signal.Notify", ctxName)
+
+	if fix, ok := c.suggestFix(cctx, call, ctxName); ok {
+		return internal.FailWithFix(msg, fix)
+	}
+	return internal.Fail(msg)
+}
+
+// suggestFix rewrites a statement-level call
+//
+//	ch := make(chan os.Signal, 1)
+//	signal.Notify(ch, sigs...)
+//
+// into
+//
+//	ctx, stop := signal.NotifyContext(ctx, sigs...)
+//	defer stop()
+//
+// No fix is suggested when the channel is used elsewhere, since those reads
+// would have to be replaced with <-ctx.Done() by hand, when the context is
+// not a context.Context variable, or inside loops. "stop" is numbered when
+// the name is already taken.
+func (*Signal) suggestFix(cctx *probe.Context, call *ast.CallExpr, ctxName string) (analysis.SuggestedFix, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) == 0 {
+		return analysis.SuggestedFix{}, false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return analysis.SuggestedFix{}, false
+	}
+
+	file := cctx.FileOf(call.Pos())
+	if file == nil {
+		return analysis.SuggestedFix{}, false
+	}
+
+	// Only statement-level calls can be replaced with a declaration.
+	path, _ := astutil.PathEnclosingInterval(file, call.Pos(), call.End())
+	if len(path) < 2 {
+		return analysis.SuggestedFix{}, false
+	}
+	stmt, ok := path[1].(*ast.ExprStmt)
+	if !ok || stmt.X != call {
+		return analysis.SuggestedFix{}, false
+	}
+
+	// The context is redeclared, so it must be a plain context.Context variable.
+	if expr, ok := cctx.ContextExprAt(call.Pos()); !ok {
+		return analysis.SuggestedFix{}, false
+	} else if _, ok := expr.(*ast.Ident); !ok {
+		return analysis.SuggestedFix{}, false
+	}
+
+	// A deferred stop inside a loop would pile up until the function returns.
+	if inLoopBody(path) {
+		return analysis.SuggestedFix{}, false
+	}
+
+	chDecl, ok := unusedSignalChannel(cctx, file, call.Args[0])
+	if !ok {
+		return analysis.SuggestedFix{}, false
+	}
+
+	tf := cctx.Pass.Fset.File(stmt.Pos())
+	if tf == nil {
+		return analysis.SuggestedFix{}, false
+	}
+
+	stop := unusedName(cctx, stmt.Pos(), "stop")
+	indent := strings.Repeat("\t", cctx.Pass.Fset.Position(stmt.Pos()).Column-1)
+
+	edits := []analysis.TextEdit{
+		{
+			// Replace "signal.Notify(ch" keeping the remaining signal arguments.
+			Pos:     stmt.Pos(),
+			End:     call.Args[0].End(),
+			NewText: fmt.Appendf(nil, "%s, %s := %s.NotifyContext(%s", ctxName, stop, pkg.Name, ctxName),
+		},
+		{
+			// Insert the deferred stop after the end of the line, keeping trailing comments in place.
+			Pos:     lineEnd(tf, stmt.End()),
+			End:     lineEnd(tf, stmt.End()),
+			NewText: []byte("\n" + indent + "defer " + stop + "()"),
+		},
+	}
+	if chDecl != nil {
+		// Remove the lines declaring the channel, which would be left unused.
+		edits = append(edits, analysis.TextEdit{
+			Pos: tf.LineStart(tf.Line(chDecl.Pos())),
+			End: lineEnd(tf, chDecl.End()) + 1,
+		})
+	}
+
+	return analysis.SuggestedFix{
+		Message:   "Replace signal.Notify with signal.NotifyContext",
+		TextEdits: edits,
+	}, true
+}
+
+// unusedSignalChannel reports whether the channel passed to signal.Notify is
+// a local variable used nowhere else. It returns the statement declaring
+// the channel, or nil when the channel is a parameter.
+func unusedSignalChannel(cctx *probe.Context, file *ast.File, arg ast.Expr) (ast.Stmt, bool) {
+	ident, ok := ast.Unparen(arg).(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	v, ok := cctx.Pass.TypesInfo.Uses[ident].(*types.Var)
+	if !ok || v.Parent() == nil || v.Parent() == cctx.Pass.Pkg.Scope() {
+		return nil, false
+	}
+	for id, obj := range cctx.Pass.TypesInfo.Uses {
+		if obj == v && id != ident {
+			return nil, false
+		}
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, v.Pos(), v.Pos())
+	for _, n := range path {
+		switch n := n.(type) {
+		case *ast.Field:
+			return nil, true
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE && len(n.Lhs) == 1 && len(n.Rhs) == 1 && isMakeCall(cctx, n.Rhs[0]) {
+				return n, true
+			}
+			return nil, false
+		case *ast.DeclStmt:
+			gen, _ := n.Decl.(*ast.GenDecl)
+			if gen == nil || len(gen.Specs) != 1 {
+				return nil, false
+			}
+			spec, _ := gen.Specs[0].(*ast.ValueSpec)
+			if spec == nil || len(spec.Names) != 1 || len(spec.Values) > 1 {
+				return nil, false
+			}
+			if len(spec.Values) == 1 && !isMakeCall(cctx, spec.Values[0]) {
+				return nil, false
+			}
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// isMakeCall checks if expr is a call to the make builtin, which can be
+// removed without losing side effects.
+func isMakeCall(cctx *probe.Context, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || !isBuiltinCall(cctx, call) {
+		return false
+	}
+	return ast.Unparen(call.Fun).(*ast.Ident).Name == "make"
+}
+
+// unusedName returns base, or base followed by the smallest number from 2
+// that is not declared in any scope enclosing pos.
+func unusedName(cctx *probe.Context, pos token.Pos, base string) string {
+	scope := cctx.Pass.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		return base
+	}
+	name := base
+	for i := 2; ; i++ {
+		if _, obj := scope.LookupParent(name, token.NoPos); obj == nil {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+}
+
+// lineEnd returns the position of the newline ending the line containing
+// pos, or the end of the file on its last line.
+func lineEnd(tf *token.File, pos token.Pos) token.Pos {
+	if line := tf.Line(pos); line < tf.LineCount() {
+		return tf.LineStart(line+1) - 1
+	}
+	return token.Pos(tf.Base() + tf.Size())
+}
@@ -0,0 +1,95 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// slogLevels are the slog functions and *slog.Logger methods that have
+// a ...Context variant taking ctx as the first argument.
+var slogLevels = map[string]bool{
+	"Debug": true,
+	"Info":  true,
+	"Warn":  true,
+	"Error": true,
+}
+
+// SlogCtx reports slog calls without context where a context is in scope,
+// including receiver struct fields when -slog-struct-ctx extends the scope:
+//
+//	func (s *server) handle() {
+//	    slog.Info("handled") // use slog.InfoContext(s.ctx, ...)
+//	}
+type SlogCtx struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*SlogCtx) Name() ignore.CheckerName {
+	return ignore.SlogCtx
+}
+
+// MatchCall returns true if the call is slog.Info etc. or a *slog.Logger level method.
+func (*SlogCtx) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	return slogFuncName(pass, call) != ""
+}
+
+// CheckCall reports the call with a fix rewriting it to the ...Context variant.
+func (*SlogCtx) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	name := slogFuncName(cctx.Pass, call)
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if name == "" || !ok {
+		return internal.OK()
+	}
+
+	ctxName := cctx.CtxNames[0]
+	msg := fmt.Sprintf("use %sContext with context %q instead of %s", name, ctxName, name)
+
+	// Carriers and names out of scope cannot be passed as the context.
+	if _, ok := cctx.ContextExprAt(call.Pos()); !ok {
+		return internal.Fail(msg)
+	}
+
+	return internal.FailWithFix(msg, analysis.SuggestedFix{
+		Message: fmt.Sprintf("Replace %s with %sContext", name, name),
+		TextEdits: []analysis.TextEdit{
+			{
+				Pos:     sel.Sel.End(),
+				End:     sel.Sel.End(),
+				NewText: []byte("Context"),
+			},
+			{
+				Pos:     call.Lparen + 1,
+				End:     call.Lparen + 1,
+				NewText: []byte(ctxName + ", "),
+			},
+		},
+	})
+}
+
+// slogFuncName returns "slog.Info" or "slog.Logger.Info" for matching calls, or "".
+func slogFuncName(pass *analysis.Pass, call *ast.CallExpr) string {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !slogLevels[sel.Sel.Name] {
+		return ""
+	}
+
+	fn, ok := pass.TypesInfo.ObjectOf(sel.Sel).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "log/slog" {
+		return ""
+	}
+
+	if fn.Signature().Recv() != nil {
+		return "slog.Logger." + fn.Name()
+	}
+	return "slog." + fn.Name()
+}
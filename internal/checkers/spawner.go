@@ -10,8 +10,10 @@ import (
 	"github.com/mpyw/goroutinectx/internal"
 	"github.com/mpyw/goroutinectx/internal/deriver"
 	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/directive/spawner"
 	"github.com/mpyw/goroutinectx/internal/funcspec"
 	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
 )
 
 // SpawnCallbackChecker checks function calls that take callbacks spawned as goroutines.
@@ -78,37 +80,61 @@ func (c *SpawnCallbackChecker) checkSingleArg(cctx *probe.Context, call *ast.Cal
 		return internal.OK()
 	}
 
-	arg := call.Args[entry.CallbackArgIdx]
-	if c.checkArg(cctx, arg) {
-		return internal.OK()
-	}
-
 	ctxName := "ctx"
 	if len(cctx.CtxNames) > 0 {
 		ctxName = cctx.CtxNames[0]
 	}
 
+	arg := call.Args[entry.CallbackArgIdx]
+	ok, confidence := c.checkArg(cctx, call, entry.CallbackArgIdx)
+	if ok {
+		if lit, ok := arg.(*ast.FuncLit); ok && cctx.Strict {
+			return c.checkStrict(cctx, lit, entry, ctxName)
+		}
+		return internal.OK()
+	}
+
 	// Format error message based on whether deriver is configured
 	if c.derivers != nil && !c.derivers.IsEmpty() {
-		return internal.Fail(fmt.Sprintf("%s() closure should use context %q or call goroutine deriver", entry.Spec.FullName(), ctxName))
+		return internal.Fail(fmt.Sprintf("%s() closure should use %s or call goroutine deriver", entry.Spec.FullName(), contextsPhrase(cctx, "one of"))).WithConfidence(confidence)
 	}
-	return internal.Fail(fmt.Sprintf("%s() closure should use context %q", entry.Spec.FullName(), ctxName))
+	return internal.Fail(fmt.Sprintf("%s() closure should use %s", entry.Spec.FullName(), contextsPhrase(cctx, "one of"))).WithConfidence(confidence)
 }
 
-func (c *SpawnCallbackChecker) checkArg(cctx *probe.Context, arg ast.Expr) bool {
+// checkStrict checks a closure inside a //goroutinectx:strict function.
+// It must pass ctx to a call and, if derivers are configured, call one.
+func (c *SpawnCallbackChecker) checkStrict(cctx *probe.Context, lit *ast.FuncLit, entry SpawnCallbackEntry, ctxName string) *internal.Result {
+	if !cctx.FuncLitPassesContext(lit) {
+		return internal.Fail(fmt.Sprintf("%s() closure in strict function should pass context %q to a call", entry.Spec.FullName(), ctxName))
+	}
+	if c.derivers != nil && !c.derivers.IsEmpty() && !c.derivers.SatisfiesAnyGroup(cctx.Pass, lit.Body) {
+		return internal.Fail(fmt.Sprintf("%s() closure in strict function should call goroutine deriver", entry.Spec.FullName()))
+	}
+	return internal.OK()
+}
+
+// checkArg checks the func argument at idx, also returning how certain
+// a failing verdict is.
+func (c *SpawnCallbackChecker) checkArg(cctx *probe.Context, call *ast.CallExpr, idx int) (bool, internal.Confidence) {
 	if len(cctx.CtxNames) == 0 {
-		return true
+		return true, internal.ConfidenceHigh
 	}
+	arg := call.Args[idx]
 
 	// Try SSA-based check first
 	if lit, ok := arg.(*ast.FuncLit); ok {
 		if result, ok := c.checkFuncLitSSA(cctx, lit); ok {
-			return result
+			return result, internal.ConfidenceMedium
+		}
+	}
+	if _, ok := arg.(*ast.Ident); ok {
+		if lits, funcs, ok := cctx.PhiFuncArgSources(call, idx); ok {
+			return c.checkPhiSources(cctx, lits, funcs), internal.ConfidenceMedium
 		}
 	}
 
 	// Fall back to AST-based check
-	return c.checkArgFromAST(cctx, arg)
+	return c.checkArgFromAST(cctx, arg), internal.ConfidenceHigh
 }
 
 // checkFuncLitSSA checks a func literal using SSA analysis.
@@ -128,8 +154,8 @@ func (c *SpawnCallbackChecker) checkFuncLitSSA(cctx *probe.Context, lit *ast.Fun
 		return false, false
 	}
 
-	// Check if closure captures context
-	if cctx.Tracer.ClosureCapturesContext(ssaFn, cctx.Carriers) {
+	// Check if closure captures context, directly or through a local closure it calls
+	if cctx.Tracer.ClosureCapturesContext(ssaFn, cctx.Carriers) || cctx.FuncLitPropagatesContext(lit) {
 		return true, true
 	}
 
@@ -144,15 +170,41 @@ func (c *SpawnCallbackChecker) checkFuncLitSSA(cctx *probe.Context, lit *ast.Fun
 	return false, true
 }
 
+// checkPhiSources checks every value merged into a func variable assigned
+// on several branches. ALL must pass, since any of them may be spawned.
+func (c *SpawnCallbackChecker) checkPhiSources(cctx *probe.Context, lits []*ast.FuncLit, funcs []*types.Func) bool {
+	for _, lit := range lits {
+		result, ok := c.checkFuncLitSSA(cctx, lit)
+		if !ok {
+			result = c.checkFuncLitAST(cctx, lit)
+		}
+		if !result {
+			return false
+		}
+	}
+	for _, fn := range funcs {
+		if !cctx.FuncRefCapturesContext(fn) {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *SpawnCallbackChecker) checkArgFromAST(cctx *probe.Context, arg ast.Expr) bool {
 	if lit, ok := arg.(*ast.FuncLit); ok {
 		return c.checkFuncLitAST(cctx, lit)
 	}
 
 	if ident, ok := arg.(*ast.Ident); ok {
+		if fn, ok := cctx.Pass.TypesInfo.ObjectOf(ident).(*types.Func); ok {
+			return cctx.FuncRefCapturesContext(fn)
+		}
+		if lits := cctx.RangeValueFuncLits(ident); lits != nil {
+			return c.checkRangedFuncLits(cctx, lits)
+		}
 		assigns := cctx.FuncLitAssignmentsOfIdent(ident)
 		if len(assigns) == 0 {
-			return true
+			return cctx.FactoryResultOfIdentUsesContext(ident)
 		}
 		return c.checkFuncLitAssignments(cctx, assigns)
 	}
@@ -200,10 +252,21 @@ func (c *SpawnCallbackChecker) checkFuncLitAssignments(cctx *probe.Context, assi
 	return true
 }
 
+// checkRangedFuncLits checks every func literal element of a ranged container.
+// ALL must pass, since any of them may be spawned.
+func (c *SpawnCallbackChecker) checkRangedFuncLits(cctx *probe.Context, lits []*ast.FuncLit) bool {
+	for _, lit := range lits {
+		if !c.checkFuncLitAST(cctx, lit) {
+			return false
+		}
+	}
+	return true
+}
+
 // checkFuncLitAST checks a func literal using AST-based analysis.
 func (c *SpawnCallbackChecker) checkFuncLitAST(cctx *probe.Context, lit *ast.FuncLit) bool {
 	// Check context capture
-	if cctx.FuncLitCapturesContext(lit) {
+	if cctx.FuncLitCapturesContext(lit) || cctx.FuncLitPropagatesContext(lit) {
 		return true
 	}
 
@@ -236,6 +299,15 @@ func NewWaitgroupChecker(derivers *deriver.Matcher) *SpawnCallbackChecker {
 	}, derivers)
 }
 
+// NewSyncOnceChecker creates the synconce checker.
+// sync.Once.Do runs its callback synchronously, but the callback is often
+// the lazy initializer that actually needs the caller's context.
+func NewSyncOnceChecker() *SpawnCallbackChecker {
+	return NewSpawnCallbackChecker(ignore.SyncOnce, []SpawnCallbackEntry{
+		{Spec: funcspec.Spec{PkgPath: "sync", TypeName: "Once", FuncName: "Do"}, CallbackArgIdx: 0},
+	}, nil)
+}
+
 // NewConcChecker creates the conc checker.
 func NewConcChecker(derivers *deriver.Matcher) *SpawnCallbackChecker {
 	return NewSpawnCallbackChecker(ignore.Errgroup, []SpawnCallbackEntry{
@@ -247,9 +319,9 @@ func NewConcChecker(derivers *deriver.Matcher) *SpawnCallbackChecker {
 		{Spec: funcspec.Spec{PkgPath: "github.com/sourcegraph/conc/pool", TypeName: "Pool", FuncName: "Go"}, CallbackArgIdx: 0},
 		// pool.ResultPool[T].Go
 		{Spec: funcspec.Spec{PkgPath: "github.com/sourcegraph/conc/pool", TypeName: "ResultPool", FuncName: "Go"}, CallbackArgIdx: 0},
-		// pool.ContextPool.Go
+		// pool.ContextPool.Go (callback receives the pool ctx as its parameter)
 		{Spec: funcspec.Spec{PkgPath: "github.com/sourcegraph/conc/pool", TypeName: "ContextPool", FuncName: "Go"}, CallbackArgIdx: 0},
-		// pool.ResultContextPool[T].Go
+		// pool.ResultContextPool[T].Go (callback receives the pool ctx as its parameter)
 		{Spec: funcspec.Spec{PkgPath: "github.com/sourcegraph/conc/pool", TypeName: "ResultContextPool", FuncName: "Go"}, CallbackArgIdx: 0},
 		// pool.ErrorPool.Go
 		{Spec: funcspec.Spec{PkgPath: "github.com/sourcegraph/conc/pool", TypeName: "ErrorPool", FuncName: "Go"}, CallbackArgIdx: 0},
@@ -310,8 +382,7 @@ func (c *SpawnerChecker) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool
 	return fn != nil && c.spawners.IsSpawner(fn)
 }
 
-// CheckCall checks the call expression.
-// Note: This checker reports directly to pass because it may have multiple failing arguments.
+// CheckCall checks the call expression, failing once per failing argument.
 func (c *SpawnerChecker) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
 	if len(cctx.CtxNames) == 0 {
 		return internal.OK()
@@ -326,55 +397,115 @@ func (c *SpawnerChecker) CheckCall(cctx *probe.Context, call *ast.CallExpr) *int
 	// Find func-typed arguments
 	funcArgs := findFuncArgs(cctx.Pass, call)
 	if len(funcArgs) == 0 {
+		if spawner.ReturnsStartFunc(fn) {
+			return c.checkStartFuncArgs(cctx, call, fn)
+		}
 		return internal.OK()
 	}
 
-	ctxName := "ctx"
-	if len(cctx.CtxNames) > 0 {
-		ctxName = cctx.CtxNames[0]
-	}
-
 	// Format error message based on whether deriver is configured
-	msgFormat := "%s() func argument should use context %q"
+	msgFormat := "%s() func argument should use %s"
 	if c.derivers != nil && !c.derivers.IsEmpty() {
-		msgFormat = "%s() func argument should use context %q or call goroutine deriver"
+		msgFormat = "%s() func argument should use %s or call goroutine deriver"
 	}
 
-	// Report each failing argument at its position
+	// Fail each failing argument at its position
+	var results []*internal.Result
 	for _, arg := range funcArgs {
-		if !c.checkFuncArg(cctx, arg) {
-			cctx.Pass.Reportf(arg.Pos(), msgFormat, fn.Name(), ctxName)
+		ok, confidence := c.checkFuncArg(cctx, arg)
+		switch {
+		case !ok:
+			results = append(results, internal.FailAt(arg.Pos(), fmt.Sprintf(msgFormat, fn.Name(), contextsPhrase(cctx, "one of"))).WithConfidence(confidence))
+		case !c.ownContextArgCallsDeriver(cctx, arg):
+			results = append(results, internal.FailAt(arg.Pos(), fmt.Sprintf("%s() func argument should call goroutine deriver", fn.Name())))
 		}
 	}
 
-	// Return OK because we handled reporting ourselves
-	return internal.OK()
+	return internal.Join(results...)
 }
 
-func (c *SpawnerChecker) checkFuncArg(cctx *probe.Context, arg ast.Expr) bool {
+// checkStartFuncArgs reports context arguments of a deferred-start spawner
+// that don't use the context in scope, since the returned start func
+// spawns goroutines carrying them.
+func (*SpawnerChecker) checkStartFuncArgs(cctx *probe.Context, call *ast.CallExpr, fn *types.Func) *internal.Result {
+	var results []*internal.Result
+	params := fn.Type().(*types.Signature).Params()
+	for i, arg := range call.Args {
+		if i >= params.Len() || !typeutil.IsContextType(params.At(i).Type()) || cctx.ArgUsesContext(arg) {
+			continue
+		}
+		results = append(results, internal.FailAt(arg.Pos(), fmt.Sprintf("%s() context argument should use %s", fn.Name(), contextsPhrase(cctx, "one of"))))
+	}
+	return internal.Join(results...)
+}
+
+// checkFuncArg checks a func argument, also returning how certain a
+// failing verdict is.
+func (c *SpawnerChecker) checkFuncArg(cctx *probe.Context, arg ast.Expr) (bool, internal.Confidence) {
 	// Try SSA-based check first
 	if lit, ok := arg.(*ast.FuncLit); ok {
 		if result, ok := c.checkFuncLitSSA(cctx, lit); ok {
-			return result
+			return result, internal.ConfidenceMedium
 		}
-		return c.checkFuncLitAST(cctx, lit)
+		return c.checkFuncLitAST(cctx, lit), internal.ConfidenceHigh
 	}
 
 	if ident, ok := arg.(*ast.Ident); ok {
+		if fn, ok := cctx.Pass.TypesInfo.ObjectOf(ident).(*types.Func); ok {
+			return cctx.FuncRefCapturesContext(fn), internal.ConfidenceHigh
+		}
 		assigns := cctx.FuncLitAssignmentsOfIdent(ident)
 		if len(assigns) == 0 {
-			return true
+			return true, internal.ConfidenceHigh
 		}
-		return c.checkFuncLitAssignments(cctx, assigns)
+		return c.checkFuncLitAssignments(cctx, assigns), internal.ConfidenceHigh
 	}
 
 	if call, ok := arg.(*ast.CallExpr); ok {
-		return cctx.FactoryCallReturnsContextUsingFunc(call)
+		return cctx.FactoryCallReturnsContextUsingFunc(call), internal.ConfidenceHigh
+	}
+
+	if sel, ok := arg.(*ast.SelectorExpr); ok {
+		return cctx.SelectorExprCapturesContext(sel), internal.ConfidenceHigh
+	}
+
+	return true, internal.ConfidenceHigh
+}
+
+// ownContextArgCallsDeriver checks that a func argument receiving its own
+// context parameter calls the deriver. The spawner passes it a context, so
+// capturing the one in scope can't stand in for the deriver call.
+func (c *SpawnerChecker) ownContextArgCallsDeriver(cctx *probe.Context, arg ast.Expr) bool {
+	if c.derivers == nil || c.derivers.IsEmpty() {
+		return true
+	}
+
+	if lit, ok := arg.(*ast.FuncLit); ok {
+		return !cctx.FuncLitHasContextParam(lit) || c.funcLitCallsDeriver(cctx, lit)
+	}
+
+	if ident, ok := arg.(*ast.Ident); ok {
+		for _, assign := range cctx.FuncLitAssignmentsOfIdent(ident) {
+			if cctx.FuncLitHasContextParam(assign.Lit) && !c.funcLitCallsDeriver(cctx, assign.Lit) {
+				return false
+			}
+		}
 	}
 
 	return true
 }
 
+// funcLitCallsDeriver checks that the func literal calls the deriver at its
+// start (SSA), falling back to AST inspection of its body.
+func (c *SpawnerChecker) funcLitCallsDeriver(cctx *probe.Context, lit *ast.FuncLit) bool {
+	if cctx.SSAProg != nil && cctx.Tracer != nil {
+		if ssaFn := cctx.SSAProg.FindFuncLit(lit); ssaFn != nil {
+			return cctx.Tracer.ClosureCallsDeriver(ssaFn, c.derivers).FoundAtStart
+		}
+	}
+	return c.derivers.SatisfiesAnyGroup(cctx.Pass, lit.Body)
+}
+
 // checkFuncLitAssignments checks all func literal assignments from last unconditional onwards.
 // ALL must pass for the check to succeed.
 func (c *SpawnerChecker) checkFuncLitAssignments(cctx *probe.Context, assigns []probe.FuncLitAssignment) bool {
@@ -418,7 +549,7 @@ func (c *SpawnerChecker) checkFuncLitSSA(cctx *probe.Context, lit *ast.FuncLit)
 		return false, false
 	}
 
-	if cctx.Tracer.ClosureCapturesContext(ssaFn, cctx.Carriers) {
+	if cctx.Tracer.ClosureCapturesContext(ssaFn, cctx.Carriers) || cctx.FuncLitPropagatesContext(lit) {
 		return true, true
 	}
 
@@ -434,7 +565,7 @@ func (c *SpawnerChecker) checkFuncLitSSA(cctx *probe.Context, lit *ast.FuncLit)
 
 // checkFuncLitAST checks a func literal using AST analysis for SpawnerChecker.
 func (c *SpawnerChecker) checkFuncLitAST(cctx *probe.Context, lit *ast.FuncLit) bool {
-	if cctx.FuncLitCapturesContext(lit) {
+	if cctx.FuncLitCapturesContext(lit) || cctx.FuncLitPropagatesContext(lit) {
 		return true
 	}
 
@@ -1,6 +1,7 @@
 package spawnerlabel
 
 import (
+	"fmt"
 	"go/ast"
 	"go/types"
 
@@ -96,24 +97,27 @@ func (c *Checker) checkFunction(pass *analysis.Pass, fnDecl *ast.FuncDecl, ignor
 	if !isMarked && spawnInfo != nil {
 		line := pass.Fset.Position(fnDecl.Pos()).Line
 		if !ignoreMap.ShouldIgnore(line, checkerName) {
-			pass.Reportf(
-				fnDecl.Name.Pos(),
-				"function %q should have //goroutinectx:spawner directive (calls %s with func argument)",
-				fnDecl.Name.Name,
-				spawnInfo.methodName,
-			)
+			pass.Report(analysis.Diagnostic{
+				Pos:      fnDecl.Name.Pos(),
+				Category: string(checkerName),
+				Message: fmt.Sprintf(
+					"function %q should have //goroutinectx:spawner directive (calls %s with func argument)",
+					fnDecl.Name.Name,
+					spawnInfo.methodName,
+				),
+			})
 		}
 	}
 
 	// Check for unnecessary label
-	if isMarked && spawnInfo == nil && !hasFuncParams(fn) {
+	if isMarked && spawnInfo == nil && !hasFuncParams(fn) && !spawner.ReturnsStartFunc(fn) {
 		line := pass.Fset.Position(fnDecl.Pos()).Line
 		if !ignoreMap.ShouldIgnore(line, checkerName) {
-			pass.Reportf(
-				fnDecl.Name.Pos(),
-				"function %q has unnecessary //goroutinectx:spawner directive",
-				fnDecl.Name.Name,
-			)
+			pass.Report(analysis.Diagnostic{
+				Pos:      fnDecl.Name.Pos(),
+				Category: string(checkerName),
+				Message:  fmt.Sprintf("function %q has unnecessary //goroutinectx:spawner directive", fnDecl.Name.Name),
+			})
 		}
 	}
 }
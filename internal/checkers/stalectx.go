@@ -0,0 +1,68 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// StaleCtx reports goroutines that call a method reading a context field of
+// its receiver instead of the context in scope. The field usually holds a
+// context captured at construction, so the caller's context is dropped:
+//
+//	func (s *server) start(ctx context.Context) {
+//	    go s.loop() // loop uses s.ctx, not ctx
+//	}
+//
+// Fields set from the context in scope (s.ctx = ctx) are not reported.
+type StaleCtx struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*StaleCtx) Name() ignore.CheckerName {
+	return ignore.StaleCtx
+}
+
+// CheckGoStmt checks the methods called by a go statement for stale context fields.
+func (*StaleCtx) CheckGoStmt(cctx *probe.Context, stmt *ast.GoStmt) *internal.Result {
+	if len(cctx.CtxNames) == 0 || cctx.ArgsUseContext(stmt.Call.Args) {
+		return internal.OK()
+	}
+
+	var methods []*ast.SelectorExpr
+	switch fun := stmt.Call.Fun.(type) {
+	case *ast.SelectorExpr:
+		methods = append(methods, fun)
+	case *ast.FuncLit:
+		if cctx.FuncLitUsesContext(fun) {
+			return internal.OK()
+		}
+		methods = calledMethods(fun.Body)
+	}
+
+	for _, sel := range methods {
+		if cctx.MethodReadsContextField(sel) && !cctx.MethodUsesContextField(sel) {
+			return internal.Fail(fmt.Sprintf("goroutine uses a stale context, not the parameter %q", cctx.CtxNames[0]))
+		}
+	}
+	return internal.OK()
+}
+
+// calledMethods returns the selectors of calls in body, skipping nested func literals.
+func calledMethods(body *ast.BlockStmt) []*ast.SelectorExpr {
+	var sels []*ast.SelectorExpr
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok {
+				sels = append(sels, sel)
+			}
+		}
+		return true
+	})
+	return sels
+}
@@ -0,0 +1,96 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// stdLogFuncs are the stdlib print functions that write without a context.
+var stdLogFuncs = []funcspec.Spec{
+	funcspec.Parse("log.Print"),
+	funcspec.Parse("log.Printf"),
+	funcspec.Parse("log.Println"),
+	funcspec.Parse("log.Logger.Print"),
+	funcspec.Parse("log.Logger.Printf"),
+	funcspec.Parse("log.Logger.Println"),
+	funcspec.Parse("fmt.Print"),
+	funcspec.Parse("fmt.Printf"),
+	funcspec.Parse("fmt.Println"),
+}
+
+// StdLog reports stdlib log and fmt print calls inside go statement
+// closures spawned where a context is in scope. Their output can't be
+// correlated with the request the goroutine works for:
+//
+//	go func() {
+//	    log.Printf("done: %v", id) // reported
+//	}()
+//
+// When a preferred logger is configured, the diagnostic names it.
+type StdLog struct {
+	preferred string
+}
+
+// NewStdLog creates a stdlib log checker. preferredLogger is an optional
+// function specification of the context-aware logger to suggest.
+func NewStdLog(preferredLogger string) *StdLog {
+	c := &StdLog{}
+	if preferredLogger != "" {
+		c.preferred = funcspec.Parse(preferredLogger).FullName()
+	}
+	return c
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*StdLog) Name() ignore.CheckerName {
+	return ignore.StdLog
+}
+
+// MatchCall returns true if the call is a stdlib print function.
+func (*StdLog) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	return stdLogFuncOf(pass, call) != nil
+}
+
+// CheckCall reports the call if it is inside a go statement closure.
+func (c *StdLog) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	file := cctx.FileOf(call.Pos())
+	if file == nil {
+		return internal.OK()
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, call.Pos(), call.End())
+	if !inGoStmtClosure(path) {
+		return internal.OK()
+	}
+
+	name := stdLogFuncOf(cctx.Pass, call).FullName()
+	if c.preferred != "" {
+		return internal.Fail("use context-aware logger " + c.preferred + " instead of " + name + " in goroutine")
+	}
+	return internal.Fail("use context-aware logger instead of " + name + " in goroutine")
+}
+
+// stdLogFuncOf returns the spec of the stdlib print function called, or nil.
+func stdLogFuncOf(pass *analysis.Pass, call *ast.CallExpr) *funcspec.Spec {
+	fn := funcspec.ExtractFunc(pass, call)
+	if fn == nil {
+		return nil
+	}
+	for i := range stdLogFuncs {
+		if stdLogFuncs[i].Matches(fn) {
+			return &stdLogFuncs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// timeTick creates a ticker that can never be stopped.
+var timeTick = funcspec.Spec{PkgPath: "time", FuncName: "Tick"}
+
+// TimeTick reports time.Tick calls where a context is in scope.
+// A time.NewTicker with a deferred Stop and a ctx.Done() case
+// ends together with the context instead of leaking.
+type TimeTick struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*TimeTick) Name() ignore.CheckerName {
+	return ignore.TimeTick
+}
+
+// MatchCall returns true if the call is time.Tick.
+func (*TimeTick) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	return fn != nil && timeTick.Matches(fn)
+}
+
+// CheckCall reports the call.
+func (*TimeTick) CheckCall(cctx *probe.Context, _ *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+	return internal.Fail("avoid time.Tick in context-aware code; use time.NewTicker with ctx.Done()")
+}
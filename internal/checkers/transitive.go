@@ -0,0 +1,195 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ssa"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/carrier"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	internalssa "github.com/mpyw/goroutinectx/internal/ssa"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// Transitive reports calls, made where a context is in scope, to helpers
+// of the same package that take no context but spawn goroutines or log,
+// directly or through other such helpers:
+//
+//	func handle(ctx context.Context) {
+//	    startWorker() // reported: startWorker can't receive ctx
+//	}
+//
+//	func startWorker() {
+//	    go work()
+//	}
+//
+// Helpers accepting a context or carrier end the search, since their
+// callers are checked on their own.
+type Transitive struct {
+	needs map[*ssa.Function]bool // Helpers needing a context, computed on first use
+}
+
+// NewTransitive creates a transitive propagation checker.
+func NewTransitive() *Transitive {
+	return &Transitive{}
+}
+
+// Name returns the checker name for ignore directive matching.
+func (*Transitive) Name() ignore.CheckerName {
+	return ignore.Transitive
+}
+
+// MatchCall returns true if the call is to a function of the analyzed package.
+func (*Transitive) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	return fn != nil && fn.Pkg() == pass.Pkg
+}
+
+// CheckCall reports the call if the helper needs a context it can't receive.
+func (c *Transitive) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	ssaCall := cctx.SSAProg.CallAt(call)
+	if ssaCall == nil {
+		return internal.OK()
+	}
+	if c.needs == nil {
+		c.needs = helpersNeedingContext(cctx.SSAProg, cctx.Carriers)
+	}
+	helper := declaredCallee(cctx.SSAProg.Pkg, &ssaCall.Call)
+	if helper == nil || !c.needs[helper] {
+		return internal.OK()
+	}
+
+	caller := cctx.SSAProg.FuncAt(call)
+	if caller == nil {
+		return internal.OK()
+	}
+
+	return internal.Fail(fmt.Sprintf("helper %s should accept context.Context to propagate from %s",
+		funcDisplayName(helper), funcDisplayName(caller))).WithConfidence(internal.ConfidenceMedium)
+}
+
+// helpersNeedingContext finds the functions declared in the package that
+// take no context but spawn a goroutine or log, themselves, in their func
+// literals, or through other such functions. The call graph is propagated
+// until a fixed point, so mutually recursive helpers are handled.
+func helpersNeedingContext(prog *internalssa.Program, carriers []carrier.Carrier) map[*ssa.Function]bool {
+	needs := make(map[*ssa.Function]bool)
+	callees := make(map[*ssa.Function][]*ssa.Function)
+	for _, fn := range prog.SrcFuncs {
+		if _, ok := fn.Syntax().(*ast.FuncDecl); !ok || acceptsContext(fn, carriers) {
+			continue
+		}
+		spawnsOrLogs := false
+		callees[fn] = scanBody(fn, prog.Pkg, &spawnsOrLogs)
+		needs[fn] = spawnsOrLogs
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for fn, called := range callees {
+			if needs[fn] {
+				continue
+			}
+			for _, callee := range called {
+				if needs[callee] {
+					needs[fn] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return needs
+}
+
+// scanBody returns the functions declared in pkg that fn and its func
+// literals call, setting spawnsOrLogs if they spawn a goroutine or log.
+func scanBody(fn *ssa.Function, pkg *ssa.Package, spawnsOrLogs *bool) []*ssa.Function {
+	var called []*ssa.Function
+	for _, block := range fn.Blocks {
+		for _, instr := range block.Instrs {
+			if _, ok := instr.(*ssa.Go); ok {
+				*spawnsOrLogs = true
+			}
+			callInstr, ok := instr.(ssa.CallInstruction)
+			if !ok {
+				continue
+			}
+			if fn := internalssa.ExtractCalledFunc(callInstr.Common()); fn != nil && isLogFunc(fn) {
+				*spawnsOrLogs = true
+			}
+			if helper := declaredCallee(pkg, callInstr.Common()); helper != nil {
+				called = append(called, helper)
+			}
+		}
+	}
+	for _, anon := range fn.AnonFuncs {
+		called = append(called, scanBody(anon, pkg, spawnsOrLogs)...)
+	}
+	return called
+}
+
+// declaredCallee returns the function declared in pkg that call statically
+// calls, or nil. Instantiations of generic functions resolve to their origin.
+func declaredCallee(pkg *ssa.Package, call *ssa.CallCommon) *ssa.Function {
+	fn := call.StaticCallee()
+	if fn == nil {
+		return nil
+	}
+	if origin := fn.Origin(); origin != nil {
+		fn = origin
+	}
+	if fn.Pkg != pkg || fn.Parent() != nil {
+		return nil
+	}
+	if _, ok := fn.Syntax().(*ast.FuncDecl); !ok {
+		return nil
+	}
+	return fn
+}
+
+// acceptsContext checks if fn has a context or carrier parameter.
+func acceptsContext(fn *ssa.Function, carriers []carrier.Carrier) bool {
+	params := fn.Signature.Params()
+	for i := range params.Len() {
+		typ := params.At(i).Type()
+		if typeutil.IsContextType(typ) || carrier.IsCarrierType(typ, carriers) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLogFunc checks if fn is a stdlib print function or a slog level
+// function or method without context.
+func isLogFunc(fn *types.Func) bool {
+	for i := range stdLogFuncs {
+		if stdLogFuncs[i].Matches(fn) {
+			return true
+		}
+	}
+	return fn.Pkg() != nil && fn.Pkg().Path() == "log/slog" && slogLevels[fn.Name()]
+}
+
+// funcDisplayName returns the name of fn, qualified by its receiver type
+// for methods (e.g., "worker.start").
+func funcDisplayName(fn *ssa.Function) string {
+	recv := fn.Signature.Recv()
+	if recv == nil {
+		return fn.Name()
+	}
+	if named, ok := typeutil.UnwrapPointer(recv.Type()).(*types.Named); ok {
+		return named.Obj().Name() + "." + fn.Name()
+	}
+	return fn.Name()
+}
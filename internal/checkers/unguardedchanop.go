@@ -0,0 +1,94 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// UnguardedChanOp reports channel sends and receives inside go statement
+// closures spawned where a context is in scope, unless they are cases of a
+// select that also waits on ctx.Done() or has a default case. Without one,
+// the goroutine leaks when nobody is left on the other side:
+//
+//	go func() {
+//	    ch <- v // reported
+//	}()
+//
+// Whether the channel is buffered is not tracked.
+type UnguardedChanOp struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*UnguardedChanOp) Name() ignore.CheckerName {
+	return ignore.UnguardedChanOp
+}
+
+// NodeTypes returns the node types this checker inspects.
+func (*UnguardedChanOp) NodeTypes() []ast.Node {
+	return []ast.Node{
+		(*ast.SendStmt)(nil),
+		(*ast.UnaryExpr)(nil),
+	}
+}
+
+// CheckNode checks "ch <- v" and "<-ch" outside a guarded select.
+func (*UnguardedChanOp) CheckNode(cctx *probe.Context, node ast.Node) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	if recv, ok := node.(*ast.UnaryExpr); ok {
+		if recv.Op != token.ARROW || isCtxDoneCall(cctx, recv.X) {
+			return internal.OK()
+		}
+	}
+
+	file := cctx.FileOf(node.Pos())
+	if file == nil {
+		return internal.OK()
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, node.Pos(), node.End())
+	if !inGoStmtClosure(path) || inGuardedSelect(cctx, path, node) {
+		return internal.OK()
+	}
+
+	return internal.Fail("blocking channel op in goroutine should be in a select with ctx.Done()").WithConfidence(internal.ConfidenceLow)
+}
+
+// inGuardedSelect checks if node is the communication of a select case,
+// and the select has a ctx.Done() or default case.
+func inGuardedSelect(cctx *probe.Context, path []ast.Node, node ast.Node) bool {
+	for i, n := range path {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		clause, ok := n.(*ast.CommClause)
+		if !ok {
+			continue
+		}
+		if clause.Comm == nil || node.Pos() < clause.Comm.Pos() || node.End() > clause.Comm.End() {
+			return false // In the case body, not the communication itself
+		}
+		if i+2 >= len(path) {
+			return false
+		}
+		sel, ok := path[i+2].(*ast.SelectStmt)
+		if !ok {
+			return false
+		}
+		for _, stmt := range sel.Body.List {
+			comm := stmt.(*ast.CommClause).Comm
+			if comm == nil || isCtxDoneRecv(cctx, comm) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
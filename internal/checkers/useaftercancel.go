@@ -0,0 +1,56 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/probe"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// UseAfterCancel reports a derived context passed to a call after its
+// cancel function has definitely been called in the same function:
+//
+//	ctx2, cancel := context.WithCancel(ctx)
+//	cancel()
+//	doWork(ctx2) // ctx2 is already canceled
+//
+// Methods called on the context itself (ctx2.Err(), ctx2.Done()) are allowed.
+type UseAfterCancel struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*UseAfterCancel) Name() ignore.CheckerName {
+	return ignore.UseAfterCancel
+}
+
+// MatchCall returns true if any argument is a context.
+func (*UseAfterCancel) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	for _, arg := range call.Args {
+		if typeutil.IsContextType(pass.TypesInfo.TypeOf(arg)) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCall checks that no context argument was canceled before the call (SSA-based).
+func (*UseAfterCancel) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if cctx.SSAProg == nil || cctx.Tracer == nil {
+		return internal.OK()
+	}
+
+	ssaCall := cctx.SSAProg.CallAt(call)
+	if ssaCall == nil {
+		return internal.OK() // Can't analyze, assume OK
+	}
+
+	for _, arg := range ssaCall.Call.Args {
+		if cctx.Tracer.CanceledBefore(arg, ssaCall) {
+			return internal.Fail("context used after cancel()").WithConfidence(internal.ConfidenceMedium)
+		}
+	}
+	return internal.OK()
+}
@@ -0,0 +1,55 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// errgroupWait returns the first error of the group, including cancellation.
+var errgroupWait = funcspec.Spec{PkgPath: "golang.org/x/sync/errgroup", TypeName: "Group", FuncName: "Wait"}
+
+// WaitError reports errgroup.Group.Wait results assigned to the blank
+// identifier where a context is in scope. Discarding the error also
+// discards the cancellation signal the group propagates.
+type WaitError struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*WaitError) Name() ignore.CheckerName {
+	return ignore.WaitError
+}
+
+// NodeTypes returns the node types this checker inspects.
+func (*WaitError) NodeTypes() []ast.Node {
+	return []ast.Node{
+		(*ast.AssignStmt)(nil),
+	}
+}
+
+// CheckNode checks "_ = g.Wait()".
+func (*WaitError) CheckNode(cctx *probe.Context, node ast.Node) *internal.Result {
+	assign, ok := node.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return internal.OK()
+	}
+
+	lhs, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || lhs.Name != "_" {
+		return internal.OK()
+	}
+
+	call, ok := ast.Unparen(assign.Rhs[0]).(*ast.CallExpr)
+	if !ok {
+		return internal.OK()
+	}
+
+	fn := funcspec.ExtractFunc(cctx.Pass, call)
+	if fn == nil || !errgroupWait.Matches(fn) {
+		return internal.OK()
+	}
+
+	return internal.Fail("errgroup.Wait() error is discarded")
+}
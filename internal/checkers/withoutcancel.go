@@ -0,0 +1,76 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/mpyw/goroutinectx/internal"
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/probe"
+)
+
+// withoutCancel detaches a context from its parent's cancellation.
+var withoutCancel = funcspec.Spec{PkgPath: "context", FuncName: "WithoutCancel"}
+
+// WithoutCancel reports context.WithoutCancel calls inside go statement
+// closures spawned where a context is in scope. A goroutine started for a
+// request should normally stop with the request.
+type WithoutCancel struct{}
+
+// Name returns the checker name for ignore directive matching.
+func (*WithoutCancel) Name() ignore.CheckerName {
+	return ignore.WithoutCancel
+}
+
+// MatchCall returns true if the call is context.WithoutCancel.
+func (*WithoutCancel) MatchCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := funcspec.ExtractFunc(pass, call)
+	return fn != nil && withoutCancel.Matches(fn)
+}
+
+// CheckCall reports the call if it is inside a go statement closure.
+func (*WithoutCancel) CheckCall(cctx *probe.Context, call *ast.CallExpr) *internal.Result {
+	if len(cctx.CtxNames) == 0 {
+		return internal.OK()
+	}
+
+	file := cctx.FileOf(call.Pos())
+	if file == nil {
+		return internal.OK()
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, call.Pos(), call.End())
+	if !inGoStmtClosure(path) {
+		return internal.OK()
+	}
+
+	return internal.Fail("context.WithoutCancel in a request goroutine detaches cancellation")
+}
+
+// inGoStmtClosure checks if any func literal in the path is spawned by
+// a go statement, as in "go func() { ... }()".
+func inGoStmtClosure(path []ast.Node) bool {
+	return goStmtClosure(path) != nil
+}
+
+// goStmtClosure returns the innermost func literal in the path that is
+// spawned by a go statement, or nil.
+func goStmtClosure(path []ast.Node) *ast.FuncLit {
+	for i, node := range path {
+		lit, ok := node.(*ast.FuncLit)
+		if !ok || i+2 >= len(path) {
+			continue
+		}
+		call, ok := path[i+1].(*ast.CallExpr)
+		if !ok || call.Fun != lit {
+			continue
+		}
+		if goStmt, ok := path[i+2].(*ast.GoStmt); ok && goStmt.Call == call {
+			return lit
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package configcheck validates configured derivers and carriers against
+// the analyzed packages, so that specs which can never match are reported
+// instead of being silently ignored.
+package configcheck
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/mpyw/goroutinectx/internal/deriver"
+	"github.com/mpyw/goroutinectx/internal/directive/carrier"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+)
+
+// Spec is a configured deriver function or carrier type.
+type Spec struct {
+	Flag string // Flag configuring the spec (e.g., "goroutine-deriver")
+	Name string // Spec as configured (e.g., "pkg/path.Func")
+
+	matchesPkg func(pkgPath string) bool
+	definedIn  func(pkg *types.Package) bool
+}
+
+// Derivers returns the specs of every deriver function in m.
+// Package wildcards come from -deriver-packages.
+func Derivers(m *deriver.Matcher) []Spec {
+	if m == nil {
+		return nil
+	}
+
+	var specs []Spec
+	for _, andGroup := range m.OrGroups {
+		for _, s := range andGroup {
+			spec := Spec{Flag: "goroutine-deriver", Name: s.PkgPath + "." + s.FuncName, matchesPkg: s.MatchesPkg, definedIn: s.DefinedIn}
+			switch {
+			case s.FuncName == funcspec.AnyFunc:
+				spec.Flag, spec.Name = "deriver-packages", s.PkgPath
+			case s.TypeName != "":
+				spec.Name = s.PkgPath + "." + s.TypeName + "." + s.FuncName
+			}
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
+
+// Carriers returns the specs of every carrier type.
+func Carriers(carriers []carrier.Carrier) []Spec {
+	specs := make([]Spec, 0, len(carriers))
+	for _, c := range carriers {
+		specs = append(specs, Spec{Flag: "context-carriers", Name: c.PkgPath + "." + c.TypeName, matchesPkg: c.MatchesPkg, definedIn: c.DefinedIn})
+	}
+	return specs
+}
+
+// Warning is a spec declared missing from a package.
+type Warning struct {
+	Pkg     *types.Package // Package expected to declare the spec
+	Message string
+}
+
+// Undefined returns a warning for each spec whose package is among pkgs or
+// their direct imports, but does not declare the configured function or type.
+// Indirect dependencies are skipped, since packages loaded from export data
+// may hold only the objects their importers refer to.
+func Undefined(specs []Spec, pkgs []*types.Package) []Warning {
+	var direct []*types.Package
+	for _, pkg := range pkgs {
+		direct = append(direct, pkg)
+		direct = append(direct, pkg.Imports()...)
+	}
+	var warnings []Warning
+	for _, spec := range specs {
+		for _, pkg := range direct {
+			if spec.matchesPkg(pkg.Path()) && !spec.definedIn(pkg) {
+				warnings = append(warnings, Warning{
+					Pkg:     pkg,
+					Message: fmt.Sprintf("-%s %s: not declared in package %s, so it never matches", spec.Flag, spec.Name, pkg.Path()),
+				})
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+// Unimported returns a warning for each spec whose package is neither
+// among pkgs nor their dependencies.
+func Unimported(specs []Spec, pkgs []*types.Package) []string {
+	all := dependencies(pkgs)
+
+	var warnings []string
+	for _, spec := range specs {
+		found := false
+		for _, pkg := range all {
+			if spec.matchesPkg(pkg.Path()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			warnings = append(warnings, fmt.Sprintf("-%s %s: package not imported by any analyzed package, so it never matches", spec.Flag, spec.Name))
+		}
+	}
+	return warnings
+}
+
+// dependencies returns pkgs and all packages they import, transitively.
+func dependencies(pkgs []*types.Package) []*types.Package {
+	seen := make(map[*types.Package]bool)
+	var all []*types.Package
+
+	var visit func(pkg *types.Package)
+	visit = func(pkg *types.Package) {
+		if pkg == nil || seen[pkg] {
+			return
+		}
+		seen[pkg] = true
+		all = append(all, pkg)
+		for _, imp := range pkg.Imports() {
+			visit(imp)
+		}
+	}
+	for _, pkg := range pkgs {
+		visit(pkg)
+	}
+
+	return all
+}
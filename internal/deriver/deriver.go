@@ -54,6 +54,26 @@ func NewMatcher(deriveFuncsStr string) *Matcher {
 	return m
 }
 
+// WithPackages appends one OR group per package to deriveFuncsStr.
+// Each group is a package wildcard ("pkg/path.*") so that any exported function
+// in the package returning a context.Context counts as a deriver.
+func WithPackages(deriveFuncsStr, pkgsStr string) string {
+	specs := []string{}
+	if s := strings.TrimSpace(deriveFuncsStr); s != "" {
+		specs = append(specs, s)
+	}
+
+	for pkg := range strings.SplitSeq(pkgsStr, ",") {
+		pkg = strings.TrimSpace(pkg)
+		if pkg == "" {
+			continue
+		}
+		specs = append(specs, pkg+"."+funcspec.AnyFunc)
+	}
+
+	return strings.Join(specs, ",")
+}
+
 // SatisfiesAnyGroup checks if the AST node satisfies ANY of the OR groups.
 func (m *Matcher) SatisfiesAnyGroup(pass *analysis.Pass, node ast.Node) bool {
 	calledFuncs := collectCalledFuncs(pass, node)
@@ -27,6 +27,18 @@
 //	# Mixed - (A AND B) OR C
 //	-goroutine-deriver=apm.Func+trace.Func,otel.Func
 //
+// # Package Wildcards
+//
+// The -deriver-packages flag lists packages where every exported function
+// returning a context.Context is a deriver. Each package becomes its own
+// OR group with a "pkg/path.*" spec (see [WithPackages]):
+//
+//	# Any apm function returning context.Context satisfies the check
+//	-deriver-packages=github.com/example/apm
+//
+//	# Equivalent to adding ",github.com/example/apm.*" to -goroutine-deriver
+//	-goroutine-deriver=otel.StartSpan -deriver-packages=github.com/example/apm
+//
 // # Function Specification Format
 //
 // Functions are specified as package path + function/method name:
@@ -16,14 +16,25 @@ type Carrier struct {
 }
 
 // Matches checks if the given type matches this carrier.
+// An interface embedding the carrier is a carrier too, and so is a type
+// parameter constrained by either.
 func (c Carrier) Matches(t types.Type) bool {
 	t = typeutil.UnwrapPointer(t)
 
+	if tp, ok := t.(*types.TypeParam); ok {
+		return typeutil.ConstraintMatches(tp, c.matchesNamed)
+	}
+
 	named, ok := t.(*types.Named)
 	if !ok {
 		return false
 	}
 
+	return c.matchesNamed(named) || typeutil.EmbedsNamed(named, c.matchesNamed)
+}
+
+// matchesNamed checks if the named type is the carrier itself.
+func (c Carrier) matchesNamed(named *types.Named) bool {
 	obj := named.Obj()
 	if obj == nil || obj.Pkg() == nil {
 		return false
@@ -32,6 +43,17 @@ func (c Carrier) Matches(t types.Type) bool {
 	return matchPkg(obj.Pkg().Path(), c.PkgPath) && obj.Name() == c.TypeName
 }
 
+// MatchesPkg checks if pkgPath is the carrier's package, allowing version suffixes.
+func (c Carrier) MatchesPkg(pkgPath string) bool {
+	return matchPkg(pkgPath, c.PkgPath)
+}
+
+// DefinedIn checks if the carrier type is declared in pkg.
+func (c Carrier) DefinedIn(pkg *types.Package) bool {
+	_, ok := pkg.Scope().Lookup(c.TypeName).(*types.TypeName)
+	return ok
+}
+
 // matchPkg checks if pkgPath matches targetPkg, allowing version suffixes.
 func matchPkg(pkgPath, targetPkg string) bool {
 	if pkgPath == targetPkg {
@@ -0,0 +1,66 @@
+package checkarg
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// Map maps directive line numbers to the argument indices to check.
+type Map map[int][]int
+
+// Build scans a file for check-arg comments and returns a map.
+func Build(fset *token.FileSet, file *ast.File) Map {
+	m := make(Map)
+
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			if indices, ok := parseComment(c.Text); ok {
+				line := fset.Position(c.Pos()).Line
+				m[line] = append(m[line], indices...)
+			}
+		}
+	}
+
+	return m
+}
+
+// parseComment parses a check-arg directive and returns the argument indices.
+// Returns false if not a check-arg comment or if no valid index is given.
+func parseComment(text string) ([]int, bool) {
+	text = strings.TrimPrefix(text, "//")
+	text = strings.TrimSpace(text)
+
+	rest, ok := strings.CutPrefix(text, "goroutinectx:check-arg")
+	if !ok {
+		return nil, false
+	}
+
+	// Stop at comment markers: " - " or " //"
+	if idx := strings.Index(rest, " - "); idx >= 0 {
+		rest = rest[:idx]
+	}
+	if idx := strings.Index(rest, " //"); idx >= 0 {
+		rest = rest[:idx]
+	}
+
+	var indices []int
+	for part := range strings.SplitSeq(rest, ",") {
+		idx, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || idx < 0 {
+			continue // Skip invalid indices
+		}
+		indices = append(indices, idx)
+	}
+
+	return indices, len(indices) > 0
+}
+
+// ArgsAt returns the argument indices marked for a call starting on the given line.
+func (m Map) ArgsAt(line int) []int {
+	if indices, ok := m[line]; ok {
+		return indices
+	}
+	return m[line-1]
+}
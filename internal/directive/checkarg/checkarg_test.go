@@ -0,0 +1,62 @@
+package checkarg
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseComment(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		want   []int
+		wantOK bool
+	}{
+		{
+			name:   "single index",
+			text:   "//goroutinectx:check-arg 2",
+			want:   []int{2},
+			wantOK: true,
+		},
+		{
+			name:   "multiple indices",
+			text:   "//goroutinectx:check-arg 0, 2",
+			want:   []int{0, 2},
+			wantOK: true,
+		},
+		{
+			name:   "trailing comment",
+			text:   "//goroutinectx:check-arg 1 - callback runs async",
+			want:   []int{1},
+			wantOK: true,
+		},
+		{
+			name:   "invalid index skipped",
+			text:   "//goroutinectx:check-arg x,1,-1",
+			want:   []int{1},
+			wantOK: true,
+		},
+		{
+			name:   "no index",
+			text:   "//goroutinectx:check-arg",
+			wantOK: false,
+		},
+		{
+			name:   "other directive",
+			text:   "//goroutinectx:ignore",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseComment(tt.text)
+			if ok != tt.wantOK {
+				t.Fatalf("parseComment(%q) ok = %v, want %v", tt.text, ok, tt.wantOK)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("parseComment(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
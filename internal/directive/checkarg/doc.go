@@ -0,0 +1,22 @@
+// Package checkarg provides //goroutinectx:check-arg directive parsing.
+//
+// # Overview
+//
+// The check-arg directive marks arguments of a single call as goroutine
+// callbacks, for one-off APIs not worth marking with //goroutinectx:spawner
+// or configuring globally:
+//
+//	//goroutinectx:check-arg 1
+//	pool.Submit("job", func() { ... })
+//
+// Argument indices are zero-based; several can be listed comma-separated:
+//
+//	//goroutinectx:check-arg 0,2
+//
+// Like ignore directives, the directive applies to a call starting on the
+// same line or on the next line.
+//
+// # Map Structure
+//
+//	type Map map[int][]int  // directive line → argument indices
+package checkarg
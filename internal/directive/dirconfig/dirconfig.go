@@ -0,0 +1,138 @@
+package dirconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/miniyaml"
+)
+
+// FileName is the name of the per-directory configuration file.
+const FileName = ".goroutinectx.yaml"
+
+// Overrides maps checker names to their enabled state.
+// Checkers not present keep the state given by flags.
+type Overrides map[ignore.CheckerName]bool
+
+// Apply returns a copy of enabled with the overrides applied.
+func (o Overrides) Apply(enabled ignore.EnabledCheckers) ignore.EnabledCheckers {
+	result := make(ignore.EnabledCheckers, len(enabled)+len(o))
+	for name, on := range enabled {
+		result[name] = on
+	}
+	for name, on := range o {
+		result[name] = on
+	}
+	return result
+}
+
+// Parse parses a configuration file. Only a small YAML subset is supported:
+//
+//	enable: [signal, timetick]
+//	disable:
+//	  - goroutine
+//
+// A checker listed under both keys is disabled.
+// Names other than the built-in checkers are rejected.
+func Parse(data []byte) (Overrides, error) {
+	enable, disable, err := parseLists(data)
+	if err != nil {
+		return nil, err
+	}
+
+	o := make(Overrides, len(enable)+len(disable))
+	for _, name := range enable {
+		o[name] = true
+	}
+	for _, name := range disable {
+		o[name] = false
+	}
+	return o, nil
+}
+
+// parseLists extracts the "enable" and "disable" lists.
+func parseLists(data []byte) ([]ignore.CheckerName, []ignore.CheckerName, error) {
+	lines, err := miniyaml.Scan(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var enable, disable []ignore.CheckerName
+	var current *[]ignore.CheckerName
+
+	for _, line := range lines {
+		// Block sequence item under the current key
+		if line.IsItem() {
+			if current == nil {
+				return nil, nil, fmt.Errorf("line %d: list item without key", line.No)
+			}
+		} else {
+			switch line.Key {
+			case "enable":
+				current = &enable
+			case "disable":
+				current = &disable
+			default:
+				return nil, nil, fmt.Errorf("line %d: unknown key %q", line.No, line.Key)
+			}
+		}
+
+		for _, item := range line.Items {
+			name := ignore.CheckerName(item)
+			if !ignore.IsBuiltin(name) {
+				return nil, nil, fmt.Errorf("line %d: unknown checker %q", line.No, item)
+			}
+			*current = append(*current, name)
+		}
+	}
+
+	return enable, disable, nil
+}
+
+// Resolve returns the overrides applying to files in dir.
+// Configuration files are read from dir up to the nearest directory
+// containing go.mod (inclusive) and merged so that nearer files win.
+// Returns nil if no configuration file is found.
+func Resolve(dir string) (Overrides, error) {
+	var paths []string
+
+	for {
+		path := filepath.Join(dir, FileName)
+		if _, err := os.Stat(path); err == nil {
+			paths = append(paths, path)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	merged := make(Overrides)
+
+	// Farthest first, so that nearer files override
+	for i := len(paths) - 1; i >= 0; i-- {
+		data, err := os.ReadFile(paths[i])
+		if err != nil {
+			return nil, err
+		}
+		o, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", paths[i], err)
+		}
+		for name, on := range o {
+			merged[name] = on
+		}
+	}
+
+	return merged, nil
+}
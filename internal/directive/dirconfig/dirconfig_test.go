@@ -0,0 +1,187 @@
+package dirconfig
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Overrides
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			input: "",
+			want:  Overrides{},
+		},
+		{
+			name:  "flow sequence",
+			input: "enable: [signal, timetick]\n",
+			want:  Overrides{ignore.Signal: true, ignore.TimeTick: true},
+		},
+		{
+			name:  "bare list",
+			input: "disable: goroutine, errgroup\n",
+			want:  Overrides{ignore.Goroutine: false, ignore.Errgroup: false},
+		},
+		{
+			name:  "block sequence",
+			input: "enable:\n  - signal\n  - nilctx\ndisable:\n  - goroutine\n",
+			want:  Overrides{ignore.Signal: true, ignore.NilCtx: true, ignore.Goroutine: false},
+		},
+		{
+			name:  "comments",
+			input: "# header\nenable: [signal] # trailing\n",
+			want:  Overrides{ignore.Signal: true},
+		},
+		{
+			name:  "disable wins",
+			input: "enable: [signal]\ndisable: [signal]\n",
+			want:  Overrides{ignore.Signal: false},
+		},
+		{
+			name:    "unknown key",
+			input:   "checkers: [signal]\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown checker",
+			input:   "disable: [errgroup, errgrup]\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown checker in block sequence",
+			input:   "enable:\n  - signal\n  - sigal\n",
+			wantErr: true,
+		},
+		{
+			name:    "item without key",
+			input:   "- signal\n",
+			wantErr: true,
+		},
+		{
+			name:    "not a mapping",
+			input:   "signal\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !maps.Equal(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, content string) {
+		t.Helper()
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("go.mod", "module example\n")
+	write(FileName, "disable: [goroutine]\n")
+	write(filepath.Join("a", FileName), "enable: [signal]\n")
+	write(filepath.Join("a", "b", FileName), "enable: [goroutine]\ndisable: [signal]\n")
+	if err := os.MkdirAll(filepath.Join(root, "c"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		dir  string
+		want Overrides
+	}{
+		{
+			name: "module root",
+			dir:  root,
+			want: Overrides{ignore.Goroutine: false},
+		},
+		{
+			name: "merged with parent",
+			dir:  filepath.Join(root, "a"),
+			want: Overrides{ignore.Goroutine: false, ignore.Signal: true},
+		},
+		{
+			name: "nearest wins",
+			dir:  filepath.Join(root, "a", "b"),
+			want: Overrides{ignore.Goroutine: true, ignore.Signal: false},
+		},
+		{
+			name: "inherited without own config",
+			dir:  filepath.Join(root, "c"),
+			want: Overrides{ignore.Goroutine: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Resolve(tt.dir)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveStopsAtModuleRoot(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, FileName), []byte("disable: [goroutine]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	mod := filepath.Join(root, "mod")
+	if err := os.MkdirAll(mod, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(mod, "go.mod"), []byte("module example\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Resolve(mod)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("Resolve() = %v, want nil", got)
+	}
+}
+
+func TestResolveUnknownChecker(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(root, FileName)
+	if err := os.WriteFile(path, []byte("enable: [signal]\ndisable:\n  - errgrup\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Resolve(root)
+	want := path + `: line 3: unknown checker "errgrup"`
+	if err == nil || err.Error() != want {
+		t.Errorf("Resolve() error = %v, want %s", err, want)
+	}
+}
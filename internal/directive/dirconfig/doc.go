@@ -0,0 +1,39 @@
+// Package dirconfig provides per-directory checker configuration.
+//
+// # Overview
+//
+// A directory may contain a .goroutinectx.yaml file that enables or
+// disables checkers for every file in that directory and its
+// subdirectories:
+//
+//	# .goroutinectx.yaml
+//	enable: [signal, timetick]
+//	disable:
+//	  - goroutine
+//
+// Checker names are the same as those accepted by
+// //goroutinectx:ignore (see [ignore] package).
+//
+// # Resolution
+//
+// Use [Resolve] to compute the overrides for a directory. Configuration
+// files are collected from the directory up to the module root (the
+// nearest directory containing go.mod) and merged so that the nearest
+// file wins:
+//
+//	module/
+//	├── go.mod
+//	├── .goroutinectx.yaml        # disable: [goroutine]
+//	└── legacy/
+//	    └── strict/
+//	        └── .goroutinectx.yaml  # enable: [goroutine]
+//
+// Here goroutine is disabled everywhere except under legacy/strict.
+//
+// # Applying Overrides
+//
+// Overrides are layered on top of the checkers enabled by flags:
+//
+//	overrides, err := dirconfig.Resolve(dir)
+//	enabled := overrides.Apply(flagEnabled)
+package dirconfig
@@ -7,8 +7,11 @@
 //
 //	directive/
 //	├── carrier/   # Context carrier type configuration
+//	├── checkarg/  # //goroutinectx:check-arg directive
+//	├── dirconfig/ # Per-directory .goroutinectx.yaml overrides
 //	├── ignore/    # //goroutinectx:ignore directive
-//	└── spawner/   # //goroutinectx:spawner directive
+//	├── spawner/   # //goroutinectx:spawner directive
+//	└── strict/    # //goroutinectx:strict directive
 //
 // # Directive Format
 //
@@ -22,6 +25,8 @@
 //	//goroutinectx:ignore goroutine
 //	//goroutinectx:ignore goroutine,errgroup
 //	//goroutinectx:spawner
+//	//goroutinectx:check-arg 1
+//	//goroutinectx:strict
 //
 // # Carrier Directive
 //
@@ -31,6 +36,16 @@
 //
 // See [carrier] package for details.
 //
+// # Directory Configuration
+//
+// Checkers can be enabled or disabled per directory with a
+// .goroutinectx.yaml file; the nearest file wins:
+//
+//	enable: [signal]
+//	disable: [goroutine]
+//
+// See [dirconfig] package for details.
+//
 // # Ignore Directive
 //
 // Suppresses warnings for the next line or same line:
@@ -59,4 +74,24 @@
 //	}
 //
 // See [spawner] package for details.
+//
+// # Check-Arg Directive
+//
+// Marks arguments of the next call as goroutine callbacks:
+//
+//	//goroutinectx:check-arg 1
+//	pool.Submit("job", func() { ... })
+//
+// See [checkarg] package for details.
+//
+// # Strict Directive
+//
+// Requires closures in a function to pass the context to a call:
+//
+//	//goroutinectx:strict
+//	func chargeCard(ctx context.Context) {
+//	    go func() { _ = ctx }()  // Warning: captured only
+//	}
+//
+// See [strict] package for details.
 package directive
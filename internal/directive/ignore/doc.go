@@ -26,17 +26,55 @@
 //
 // # Valid Checker Names
 //
-//	┌─────────────────┬─────────────────────────────────────────────┐
-//	│ Name            │ Description                                 │
-//	├─────────────────┼─────────────────────────────────────────────┤
-//	│ goroutine       │ go statement context propagation            │
-//	│ goroutinederive │ go statement deriver function calls         │
-//	│ errgroup        │ errgroup.Group.Go callback context          │
-//	│ waitgroup       │ sync.WaitGroup.Go callback context          │
-//	│ spawner         │ //goroutinectx:spawner function calls       │
-//	│ spawnerlabel    │ Spawner label directive validation          │
-//	│ gotask          │ gotask library function calls               │
-//	└─────────────────┴─────────────────────────────────────────────┘
+//	┌───────────────────┬─────────────────────────────────────────────┐
+//	│ Name              │ Description                                 │
+//	├───────────────────┼─────────────────────────────────────────────┤
+//	│ goroutine         │ go statement context propagation            │
+//	│ goroutinederive   │ go statement deriver function calls         │
+//	│ errgroup          │ errgroup.Group.Go callback context          │
+//	│ waitgroup         │ sync.WaitGroup.Go callback context          │
+//	│ spawner           │ //goroutinectx:spawner function calls       │
+//	│ spawnerlabel      │ Spawner label directive validation          │
+//	│ gotask            │ gotask library function calls               │
+//	│ signal            │ signal.Notify where ctx is in scope         │
+//	│ nilctx            │ context.Context assigned/compared to nil    │
+//	│ groupctx          │ errgroup.WithContext context never used     │
+//	│ timetick          │ time.Tick where ctx is in scope             │
+//	│ withoutcancel     │ context.WithoutCancel in request goroutine  │
+//	│ returnctxerr      │ errgroup Done case returning nil            │
+//	│ blockingio        │ blocking I/O call in goroutine              │
+//	│ ctxinslice        │ context stored in slice/map in goroutine    │
+//	│ ctxchansend       │ context sent on channel from goroutine      │
+//	│ useaftercancel    │ derived context used after cancel()         │
+//	│ ctxrequired       │ -ctx-required-funcs call without ctx        │
+//	│ execcommand       │ exec.Command where ctx is in scope          │
+//	│ slogctx           │ slog call without ...Context variant        │
+//	│ redundantderive   │ goroutine calling deriver more than once    │
+//	│ waiterror         │ errgroup.Wait error assigned to _           │
+//	│ checkarg          │ //goroutinectx:check-arg call arguments     │
+//	│ requestctx        │ request context replaced with Background    │
+//	│ stalectx          │ goroutine method using receiver ctx field   │
+//	│ printctx          │ context formatted by fmt with %v            │
+//	│ ignoredctxerr     │ ctx.Err() result discarded                  │
+//	│ loopbackground    │ context.Background() recreated in loop      │
+//	│ ctxindto          │ context field in JSON-tagged struct         │
+//	│ ctxvalueassert    │ ctx.Value(k).(T) without comma-ok           │
+//	│ handlermap        │ dispatch map handler without ctx param      │
+//	│ ctxparamfield     │ unused ctx param beside receiver ctx field  │
+//	│ ctxincache        │ context passed to a cache Set function      │
+//	│ initgoroutine     │ goroutine spawned during package init       │
+//	│ escapinggoroutine │ bare goroutine inside a managed group       │
+//	│ backoff           │ backoff.Retry policy without WithContext    │
+//	│ synconce          │ sync.Once.Do callback without ctx           │
+//	│ ctxmapkey         │ context.Context used as a map key           │
+//	│ ctxinconstructor  │ constructor storing its ctx on the struct   │
+//	│ stdlog            │ stdlib log/fmt print call in goroutine      │
+//	│ unguardedchanop   │ channel op in goroutine without ctx.Done()  │
+//	│ ctxfreevarstore   │ captured ctx reassigned in goroutine        │
+//	│ transitive        │ ctx-less helper that spawns or logs         │
+//	│ lockedgoroutine   │ goroutine awaited while holding a lock      │
+//	│ closurestore      │ ctx-capturing closure stored in a registry  │
+//	└───────────────────┴─────────────────────────────────────────────┘
 //
 // # Parsing
 //
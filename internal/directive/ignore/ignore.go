@@ -10,17 +10,109 @@ import (
 // CheckerName represents a checker that can be ignored.
 type CheckerName string
 
-// Valid checker names.
+// Valid checker names. Each must also be listed in builtin.
 const (
-	Goroutine       CheckerName = "goroutine"
-	GoroutineDerive CheckerName = "goroutinederive"
-	Waitgroup       CheckerName = "waitgroup"
-	Errgroup        CheckerName = "errgroup"
-	Spawner         CheckerName = "spawner"
-	Spawnerlabel    CheckerName = "spawnerlabel"
-	Gotask          CheckerName = "gotask"
+	Goroutine         CheckerName = "goroutine"
+	GoroutineDerive   CheckerName = "goroutinederive"
+	Waitgroup         CheckerName = "waitgroup"
+	Errgroup          CheckerName = "errgroup"
+	Spawner           CheckerName = "spawner"
+	Spawnerlabel      CheckerName = "spawnerlabel"
+	Gotask            CheckerName = "gotask"
+	Signal            CheckerName = "signal"
+	NilCtx            CheckerName = "nilctx"
+	GroupCtx          CheckerName = "groupctx"
+	TimeTick          CheckerName = "timetick"
+	WithoutCancel     CheckerName = "withoutcancel"
+	ReturnCtxErr      CheckerName = "returnctxerr"
+	BlockingIO        CheckerName = "blockingio"
+	CtxInSlice        CheckerName = "ctxinslice"
+	CtxChanSend       CheckerName = "ctxchansend"
+	UseAfterCancel    CheckerName = "useaftercancel"
+	CtxRequired       CheckerName = "ctxrequired"
+	ExecCommand       CheckerName = "execcommand"
+	SlogCtx           CheckerName = "slogctx"
+	RedundantDerive   CheckerName = "redundantderive"
+	WaitError         CheckerName = "waiterror"
+	CheckArg          CheckerName = "checkarg"
+	RequestCtx        CheckerName = "requestctx"
+	StaleCtx          CheckerName = "stalectx"
+	PrintCtx          CheckerName = "printctx"
+	IgnoredCtxErr     CheckerName = "ignoredctxerr"
+	LoopBackground    CheckerName = "loopbackground"
+	CtxInDTO          CheckerName = "ctxindto"
+	CtxValueAssert    CheckerName = "ctxvalueassert"
+	HandlerMap        CheckerName = "handlermap"
+	CtxParamField     CheckerName = "ctxparamfield"
+	CtxInCache        CheckerName = "ctxincache"
+	InitGoroutine     CheckerName = "initgoroutine"
+	EscapingGoroutine CheckerName = "escapinggoroutine"
+	Backoff           CheckerName = "backoff"
+	SyncOnce          CheckerName = "synconce"
+	CtxMapKey         CheckerName = "ctxmapkey"
+	CtxInConstructor  CheckerName = "ctxinconstructor"
+	StdLog            CheckerName = "stdlog"
+	UnguardedChanOp   CheckerName = "unguardedchanop"
+	CtxFreeVarStore   CheckerName = "ctxfreevarstore"
+	Transitive        CheckerName = "transitive"
+	LockedGoroutine   CheckerName = "lockedgoroutine"
+	ClosureStore      CheckerName = "closurestore"
 )
 
+// builtin holds the names of the built-in checkers.
+var builtin = map[CheckerName]bool{
+	Goroutine:         true,
+	GoroutineDerive:   true,
+	Waitgroup:         true,
+	Errgroup:          true,
+	Spawner:           true,
+	Spawnerlabel:      true,
+	Gotask:            true,
+	Signal:            true,
+	NilCtx:            true,
+	GroupCtx:          true,
+	TimeTick:          true,
+	WithoutCancel:     true,
+	ReturnCtxErr:      true,
+	BlockingIO:        true,
+	CtxInSlice:        true,
+	CtxChanSend:       true,
+	UseAfterCancel:    true,
+	CtxRequired:       true,
+	ExecCommand:       true,
+	SlogCtx:           true,
+	RedundantDerive:   true,
+	WaitError:         true,
+	CheckArg:          true,
+	RequestCtx:        true,
+	StaleCtx:          true,
+	PrintCtx:          true,
+	IgnoredCtxErr:     true,
+	LoopBackground:    true,
+	CtxInDTO:          true,
+	CtxValueAssert:    true,
+	HandlerMap:        true,
+	CtxParamField:     true,
+	CtxInCache:        true,
+	InitGoroutine:     true,
+	EscapingGoroutine: true,
+	Backoff:           true,
+	SyncOnce:          true,
+	CtxMapKey:         true,
+	CtxInConstructor:  true,
+	StdLog:            true,
+	UnguardedChanOp:   true,
+	CtxFreeVarStore:   true,
+	Transitive:        true,
+	LockedGoroutine:   true,
+	ClosureStore:      true,
+}
+
+// IsBuiltin reports whether name is a built-in checker.
+func IsBuiltin(name CheckerName) bool {
+	return builtin[name]
+}
+
 // Entry tracks an ignore directive and its usage.
 type Entry struct {
 	pos      token.Pos            // Position of the ignore comment
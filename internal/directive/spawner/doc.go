@@ -23,6 +23,23 @@
 //	    })
 //	}
 //
+// # Deferred Start
+//
+// A spawner may instead take the context and return a start func that
+// spawns the work when called. Its context arguments are checked instead:
+//
+//	//goroutinectx:spawner
+//	func spawnLater(ctx context.Context) func() {
+//	    return func() { go work(ctx) }
+//	}
+//
+//	func handler(ctx context.Context) {
+//	    start := spawnLater(context.Background())  // Warning: should use context
+//	    start()
+//	}
+//
+// See [ReturnsStartFunc].
+//
 // # Parsing
 //
 // Use [Parse] to find all spawner-marked functions in a package:
@@ -64,7 +81,22 @@
 //
 //	-external-spawner=mycompany/pkg.RunAsync
 //
-// The directive is only for functions in the analyzed package.
+// The flag is only needed for packages that are not analyzed themselves
+// (e.g., third-party code without the directive).
+//
+// # Cross-Package Spawners
+//
+// Each spawner-marked function is exported as a [Fact], so directives in
+// an imported package apply to callers in the importing package:
+//
+//	// package worker
+//	//goroutinectx:spawner
+//	func Go(fn func()) { go fn() }
+//
+//	// package handler
+//	func handle(ctx context.Context) {
+//	    worker.Go(func() {})  // Warning: should use context
+//	}
 //
 // # Interaction with Checkers
 //
@@ -0,0 +1,66 @@
+package spawner
+
+import (
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzer collects //goroutinectx:spawner directives and propagates them
+// to importing packages as facts.
+//
+// It is kept separate from the main analyzer so that only this lightweight
+// pass runs on dependencies; the main analyzer declares no facts and is
+// applied to the analyzed packages alone.
+var Analyzer = &analysis.Analyzer{
+	Name:       "goroutinectxspawner",
+	Doc:        "collects //goroutinectx:spawner directives and exports them as facts",
+	Run:        run,
+	FactTypes:  []analysis.Fact{(*Fact)(nil)},
+	ResultType: reflect.TypeFor[*Marked](),
+}
+
+// Fact marks a function as a spawner so that importing packages
+// recognize it without re-reading the directive.
+type Fact struct{}
+
+// AFact implements analysis.Fact.
+func (*Fact) AFact() {}
+
+// String implements fmt.Stringer for analysistest fact expectations.
+func (*Fact) String() string {
+	return "spawner"
+}
+
+// Marked is the result of [Analyzer].
+type Marked struct {
+	Local    map[*types.Func]struct{} // Marked in the analyzed package
+	Imported map[*types.Func]struct{} // Marked in dependencies (via facts)
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	marked := &Marked{
+		Local:    make(map[*types.Func]struct{}),
+		Imported: make(map[*types.Func]struct{}),
+	}
+
+	for _, file := range pass.Files {
+		buildForFile(pass, file, marked.Local)
+	}
+
+	for fn := range marked.Local {
+		pass.ExportObjectFact(fn, &Fact{})
+	}
+
+	for _, fact := range pass.AllObjectFacts() {
+		if _, ok := fact.Fact.(*Fact); !ok {
+			continue
+		}
+		if fn, ok := fact.Object.(*types.Func); ok && fn.Pkg() != pass.Pkg {
+			marked.Imported[fn] = struct{}{}
+		}
+	}
+
+	return marked, nil
+}
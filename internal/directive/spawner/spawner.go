@@ -9,11 +9,13 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
 )
 
 // Map tracks functions marked with //goroutinectx:spawner.
 type Map struct {
 	local    map[*types.Func]struct{}
+	imported map[*types.Func]struct{}
 	external []funcspec.Spec
 }
 
@@ -27,15 +29,42 @@ func (m *Map) IsSpawner(fn *types.Func) bool {
 		return true
 	}
 
+	if _, ok := m.imported[fn.Origin()]; ok {
+		return true
+	}
+
 	return m.matchesExternal(fn)
 }
 
+// ReturnsStartFunc checks if fn has the deferred-start shape of a spawner:
+// it takes a context.Context and returns a func that spawns the work later.
+//
+//	//goroutinectx:spawner
+//	func spawnLater(ctx context.Context) func() {
+//	    return func() { go work(ctx) }
+//	}
+func ReturnsStartFunc(fn *types.Func) bool {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Results().Len() == 0 {
+		return false
+	}
+	if _, ok := sig.Results().At(0).Type().Underlying().(*types.Signature); !ok {
+		return false
+	}
+	for v := range sig.Params().Variables() {
+		if typeutil.IsContextType(v.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
 // Len returns the total number of spawners.
 func (m *Map) Len() int {
 	if m == nil {
 		return 0
 	}
-	return len(m.local) + len(m.external)
+	return len(m.local) + len(m.imported) + len(m.external)
 }
 
 // matchesExternal checks if fn matches any external spec.
@@ -48,16 +77,19 @@ func (m *Map) matchesExternal(fn *types.Func) bool {
 	return false
 }
 
-// Build scans files for functions marked with the directive
-// and parses the external spawner flag.
+// Build combines the spawners collected by [Analyzer] (local directives and
+// facts imported from dependencies) with the external spawner flag.
+// [Analyzer] must be in the Requires of the calling analyzer.
 func Build(pass *analysis.Pass, externalSpawners string) *Map {
 	m := &Map{
 		local:    make(map[*types.Func]struct{}),
+		imported: make(map[*types.Func]struct{}),
 		external: parseExternal(externalSpawners),
 	}
 
-	for _, file := range pass.Files {
-		buildForFile(pass, file, m.local)
+	if marked, ok := pass.ResultOf[Analyzer].(*Marked); ok {
+		m.local = marked.Local
+		m.imported = marked.Imported
 	}
 
 	return m
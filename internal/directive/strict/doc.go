@@ -0,0 +1,31 @@
+// Package strict provides //goroutinectx:strict directive parsing.
+//
+// # Overview
+//
+// The strict directive raises the checking level for goroutines and
+// errgroup, waitgroup and conc closures inside a single function, so that
+// critical code paths can be held to a higher standard while the rest of
+// the codebase stays lenient:
+//
+//	//goroutinectx:strict
+//	func handleOrder(ctx context.Context) {
+//	    go func() {
+//	        _ = ctx // Reported: captured but never passed to a call
+//	    }()
+//	    go func() {
+//	        process(ctx) // OK
+//	    }()
+//	}
+//
+// Inside a strict function, a closure must pass the context to a call
+// (including method calls such as ctx.Done()); merely capturing it is not
+// enough. When -goroutine-deriver is configured, errgroup, waitgroup and
+// conc closures must also call the deriver, even if they use the context.
+//
+// The directive may appear anywhere in the function's doc comment and
+// covers every closure nested in the function.
+//
+// # Set Structure
+//
+//	type Set map[*ast.FuncDecl]bool  // strict function declarations
+package strict
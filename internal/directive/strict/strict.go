@@ -0,0 +1,58 @@
+package strict
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Set holds the function declarations marked with //goroutinectx:strict.
+type Set map[*ast.FuncDecl]bool
+
+// Build scans the doc comments of function declarations for strict directives.
+func Build(pass *analysis.Pass, skipFiles map[string]bool) Set {
+	s := make(Set)
+
+	for _, file := range pass.Files {
+		if skipFiles[pass.Fset.Position(file.Pos()).Filename] {
+			continue
+		}
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Doc == nil {
+				continue
+			}
+			for _, c := range funcDecl.Doc.List {
+				if isStrictComment(c.Text) {
+					s[funcDecl] = true
+					break
+				}
+			}
+		}
+	}
+
+	return s
+}
+
+// Encloses reports whether any function declaration in the stack is strict.
+func (s Set) Encloses(stack []ast.Node) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for _, n := range stack {
+		if decl, ok := n.(*ast.FuncDecl); ok && s[decl] {
+			return true
+		}
+	}
+	return false
+}
+
+// isStrictComment checks if a comment is a strict directive.
+func isStrictComment(text string) bool {
+	text = strings.TrimPrefix(text, "//")
+	text = strings.TrimSpace(text)
+
+	rest, ok := strings.CutPrefix(text, "goroutinectx:strict")
+	return ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t')
+}
@@ -0,0 +1,45 @@
+package strict
+
+import "testing"
+
+func TestIsStrictComment(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{
+			name: "directive",
+			text: "//goroutinectx:strict",
+			want: true,
+		},
+		{
+			name: "leading space",
+			text: "// goroutinectx:strict",
+			want: true,
+		},
+		{
+			name: "trailing comment",
+			text: "//goroutinectx:strict - payment path",
+			want: true,
+		},
+		{
+			name: "longer directive name",
+			text: "//goroutinectx:strictness",
+			want: false,
+		},
+		{
+			name: "other directive",
+			text: "//goroutinectx:spawner",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isStrictComment(tt.text); got != tt.want {
+				t.Errorf("isStrictComment(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
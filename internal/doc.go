@@ -38,11 +38,14 @@
 //   - [GoStmtChecker]: Checks go statements (e.g., `go func() { ... }()`)
 //   - [CallChecker]: Checks function call expressions (e.g., `g.Go(func() { ... })`)
 //
+// Opt-in rules that inspect other nodes implement [NodeChecker], declaring
+// the node types they need via NodeTypes.
+//
 // Example checker registration:
 //
 //	goStmtCheckers := []GoStmtChecker{
-//	    &checkers.Goroutine{},
-//	    checkers.NewGoroutineDerive(deriveMatcher),
+//	    checkers.NewGoroutine(checkers.MessageStyleLegacy, ""),
+//	    checkers.NewGoroutineDerive(deriveMatcher, checkers.MessageStyleLegacy, false),
 //	}
 //	callCheckers := []CallChecker{
 //	    checkers.NewErrgroupChecker(deriveMatcher),
@@ -57,6 +60,7 @@
 //  4. For each node in a context-aware scope:
 //     - go statements -> [GoStmtChecker.CheckGoStmt]
 //     - call expressions -> [CallChecker.CheckCall]
+//     - other requested nodes -> [NodeChecker.CheckNode]
 //  5. Results are reported via pass.Reportf
 //
 // # Result Handling
@@ -11,6 +11,7 @@
 //
 //	pkg/path.FuncName           # Package-level function
 //	pkg/path.TypeName.Method    # Method on type
+//	pkg/path.*                  # Any exported function returning context.Context
 //
 // Examples:
 //
@@ -53,6 +54,8 @@
 //   - Package path matching (including version suffixes like /v2)
 //   - Type name for methods
 //   - Function/method name
+//   - The [AnyFunc] wildcard, which matches exported package-level functions
+//     whose results include a context.Context
 //
 // # Full Name
 //
@@ -12,8 +12,12 @@ import (
 	"github.com/mpyw/goroutinectx/internal/typeutil"
 )
 
+// AnyFunc is the FuncName wildcard matching every exported package-level
+// function that returns a context.Context, as in "pkg/path.*".
+const AnyFunc = "*"
+
 // Spec holds parsed components of a function specification.
-// Format: "pkg/path.Func" or "pkg/path.Type.Method".
+// Format: "pkg/path.Func", "pkg/path.Type.Method" or "pkg/path.*".
 type Spec struct {
 	PkgPath  string
 	TypeName string // empty for package-level functions
@@ -59,6 +63,10 @@ func (s Spec) FullName() string {
 
 // Matches checks if a types.Func matches this specification.
 func (s Spec) Matches(fn *types.Func) bool {
+	if s.FuncName == AnyFunc {
+		return s.matchesAnyFunc(fn)
+	}
+
 	if fn.Name() != s.FuncName {
 		return false
 	}
@@ -92,6 +100,57 @@ func (s Spec) Matches(fn *types.Func) bool {
 	return named.Obj().Name() == s.TypeName
 }
 
+// MatchesPkg checks if pkgPath is the spec's package, allowing version suffixes.
+func (s Spec) MatchesPkg(pkgPath string) bool {
+	return matchPkg(pkgPath, s.PkgPath)
+}
+
+// DefinedIn checks if the spec's function or method is declared in pkg.
+// The wildcard is defined in every package.
+func (s Spec) DefinedIn(pkg *types.Package) bool {
+	if s.FuncName == AnyFunc {
+		return true
+	}
+
+	if s.TypeName == "" {
+		_, ok := pkg.Scope().Lookup(s.FuncName).(*types.Func)
+		return ok
+	}
+
+	typeName, ok := pkg.Scope().Lookup(s.TypeName).(*types.TypeName)
+	if !ok {
+		return false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(typeName.Type()), true, pkg, s.FuncName)
+	_, ok = obj.(*types.Func)
+	return ok
+}
+
+// matchesAnyFunc checks if fn is an exported package-level function
+// in the spec's package that returns a context.Context.
+func (s Spec) matchesAnyFunc(fn *types.Func) bool {
+	if !fn.Exported() {
+		return false
+	}
+
+	pkg := fn.Pkg()
+	if pkg == nil || !matchPkg(pkg.Path(), s.PkgPath) {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() != nil {
+		return false
+	}
+
+	for v := range sig.Results().Variables() {
+		if typeutil.IsContextType(v.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
 // ExtractFunc extracts the types.Func from a call expression.
 func ExtractFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
 	switch fun := call.Fun.(type) {
@@ -0,0 +1,168 @@
+// Package libspec loads library spec bundles.
+//
+// A bundle describes the context-related APIs of any number of libraries in
+// one file, so that a library is supported by configuration rather than a
+// Go code change:
+//
+//	# ctxrelay-libs.yaml
+//	jobqueue:
+//	  spawners: [github.com/example/jobqueue.Queue.Spawn]
+//	  derivers: [github.com/example/jobqueue.WithJob]
+//	  ctx-required:
+//	    - github.com/example/jobqueue.Enqueue:0
+//
+// Each top-level key names a library and each nested key lists specs in
+// the format of the corresponding flag. The specs of all libraries are
+// appended to the flag values.
+package libspec
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/mpyw/goroutinectx/internal/miniyaml"
+)
+
+// FileName is the conventional name of a bundle file.
+const FileName = "ctxrelay-libs.yaml"
+
+// Kind is a kind of spec a library can declare.
+type Kind string
+
+// Spec kinds, each extending the flag named in its comment.
+const (
+	Spawners       Kind = "spawners"        // -external-spawner
+	Derivers       Kind = "derivers"        // -goroutine-deriver
+	CtxRequired    Kind = "ctx-required"    // -ctx-required-funcs
+	Carriers       Kind = "carriers"        // -context-carriers
+	FireAndForget  Kind = "fire-and-forget" // -fire-and-forget-funcs
+	Blocking       Kind = "blocking"        // -blocking-funcs
+	CacheSet       Kind = "cache-set"       // -cache-set-funcs
+	AsyncLaunchers Kind = "async-launchers" // -async-launchers
+	ClosureStore   Kind = "closure-store"   // -closure-store-funcs
+)
+
+// kinds is the set of valid spec kinds.
+var kinds = map[Kind]bool{
+	Spawners:       true,
+	Derivers:       true,
+	CtxRequired:    true,
+	Carriers:       true,
+	FireAndForget:  true,
+	Blocking:       true,
+	CacheSet:       true,
+	AsyncLaunchers: true,
+	ClosureStore:   true,
+}
+
+// Library is the specs declared for one library.
+type Library struct {
+	Name  string
+	Specs map[Kind][]string
+}
+
+// Bundle is a parsed bundle file. A nil Bundle declares nothing.
+type Bundle struct {
+	Libraries []Library
+}
+
+// Join appends the specs of kind declared by every library to the
+// comma-separated flag value.
+func (b *Bundle) Join(kind Kind, value string) string {
+	if b == nil {
+		return value
+	}
+
+	var parts []string
+	if value != "" {
+		parts = append(parts, value)
+	}
+	for _, lib := range b.Libraries {
+		parts = append(parts, lib.Specs[kind]...)
+	}
+	return strings.Join(parts, ",")
+}
+
+// loaded caches Load results by path. A bundle is read once per process
+// rather than once per analyzed package.
+var loaded sync.Map // path -> *loadResult
+
+// loadResult is the outcome of loading one bundle file.
+type loadResult struct {
+	once   sync.Once
+	bundle *Bundle
+	err    error
+}
+
+// Load reads and parses the bundle file at path. The file is read once;
+// later calls with the same path return the same result.
+func Load(path string) (*Bundle, error) {
+	v, _ := loaded.LoadOrStore(path, &loadResult{})
+	r := v.(*loadResult)
+	r.once.Do(func() {
+		r.bundle, r.err = load(path)
+	})
+	return r.bundle, r.err
+}
+
+// load reads and parses the bundle file at path.
+func load(path string) (*Bundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return b, nil
+}
+
+// Parse parses a bundle file in the YAML subset of [miniyaml]:
+// unindented library keys, indented spec kind keys, and flow ("[a, b]")
+// or block ("- a") sequences of specs.
+func Parse(data []byte) (*Bundle, error) {
+	lines, err := miniyaml.Scan(data)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bundle{}
+	var specs map[Kind][]string // Specs of the current library
+	var kind Kind
+
+	for _, line := range lines {
+		// Block sequence item under the current spec kind
+		if line.IsItem() {
+			if kind == "" {
+				return nil, fmt.Errorf("line %d: list item without key", line.No)
+			}
+			specs[kind] = append(specs[kind], line.Items...)
+			continue
+		}
+
+		// Unindented key starts a library
+		if !line.Indented {
+			if line.Value != "" {
+				return nil, fmt.Errorf("line %d: library %q must map spec kinds", line.No, line.Key)
+			}
+			specs = make(map[Kind][]string)
+			b.Libraries = append(b.Libraries, Library{Name: line.Key, Specs: specs})
+			kind = ""
+			continue
+		}
+
+		if specs == nil {
+			return nil, fmt.Errorf("line %d: spec kind %q outside a library", line.No, line.Key)
+		}
+		kind = Kind(line.Key)
+		if !kinds[kind] {
+			return nil, fmt.Errorf("line %d: unknown spec kind %q", line.No, line.Key)
+		}
+		specs[kind] = append(specs[kind], line.Items...)
+	}
+
+	return b, nil
+}
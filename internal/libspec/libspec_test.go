@@ -0,0 +1,150 @@
+package libspec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		kind    Kind
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			input: "",
+			kind:  Spawners,
+			want:  "",
+		},
+		{
+			name:  "flow sequence",
+			input: "jobs:\n  spawners: [jobs.Queue.Spawn, jobs.Go]\n",
+			kind:  Spawners,
+			want:  "jobs.Queue.Spawn,jobs.Go",
+		},
+		{
+			name:  "block sequence",
+			input: "jobs:\n  ctx-required:\n    - jobs.Enqueue:0\n    - jobs.Client.Send:1\n",
+			kind:  CtxRequired,
+			want:  "jobs.Enqueue:0,jobs.Client.Send:1",
+		},
+		{
+			name:  "closure store",
+			input: "hooks:\n  closure-store: [hooks.Registry.Add]\n",
+			kind:  ClosureStore,
+			want:  "hooks.Registry.Add",
+		},
+		{
+			name:  "multiple libraries",
+			input: "jobs:\n  derivers: [jobs.WithJob]\ntrace:\n  derivers: [trace.Start]\n",
+			kind:  Derivers,
+			want:  "jobs.WithJob,trace.Start",
+		},
+		{
+			name:  "comments",
+			input: "# header\njobs: # library\n  carriers: [jobs.Context] # trailing\n",
+			kind:  Carriers,
+			want:  "jobs.Context",
+		},
+		{
+			name:  "async launchers",
+			input: "jobs:\n  async-launchers: [jobs.Job.Start:WithContext, jobs.Job.Run:1]\n",
+			kind:  AsyncLaunchers,
+			want:  "jobs.Job.Start:WithContext,jobs.Job.Run:1",
+		},
+		{
+			name:  "other kinds ignored",
+			input: "jobs:\n  spawners: [jobs.Go]\n",
+			kind:  Blocking,
+			want:  "",
+		},
+		{
+			name:    "unknown kind",
+			input:   "jobs:\n  retry-callbacks: [jobs.Retry]\n",
+			wantErr: true,
+		},
+		{
+			name:    "kind outside library",
+			input:   "  spawners: [jobs.Go]\n",
+			wantErr: true,
+		},
+		{
+			name:    "library with value",
+			input:   "jobs: [jobs.Go]\n",
+			wantErr: true,
+		},
+		{
+			name:    "item without key",
+			input:   "jobs:\n  - jobs.Go\n",
+			wantErr: true,
+		},
+		{
+			name:    "not a mapping",
+			input:   "jobs\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if joined := got.Join(tt.kind, ""); joined != tt.want {
+				t.Errorf("Join(%q) = %q, want %q", tt.kind, joined, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	b, err := Parse([]byte("jobs:\n  spawners: [jobs.Go]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := b.Join(Spawners, "pool.Submit"), "pool.Submit,jobs.Go"; got != want {
+		t.Errorf("Join() = %q, want %q", got, want)
+	}
+	if got, want := b.Join(Derivers, "apm.Start"), "apm.Start"; got != want {
+		t.Errorf("Join() = %q, want %q", got, want)
+	}
+
+	var nilBundle *Bundle
+	if got, want := nilBundle.Join(Spawners, "pool.Submit"), "pool.Submit"; got != want {
+		t.Errorf("nil Join() = %q, want %q", got, want)
+	}
+}
+
+func TestLoadOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := os.WriteFile(path, []byte("jobs:\n  spawners: [jobs.Go]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A rewrite is not seen: the file is read once per process
+	if err := os.WriteFile(path, []byte("jobs:\n  spawners: [jobs.Other]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	second, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first != second {
+		t.Errorf("Load() returned a new bundle for the same path")
+	}
+}
@@ -0,0 +1,91 @@
+// Package miniyaml scans the small YAML subset used by the configuration
+// files of goroutinectx:
+//
+//	key:            # unindented key
+//	  nested: [a, b] # indented key with a flow sequence
+//	  other:
+//	    - c          # block sequence item
+//
+// Comments and blank lines are skipped. Callers give the keys their
+// meaning and decide which nesting is valid.
+package miniyaml
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Line is a significant line of a file.
+type Line struct {
+	No       int      // 1-based line number
+	Indented bool     // The line starts with whitespace
+	Key      string   // Key of a "key: value" line; empty for a block sequence item
+	Value    string   // Raw value after the colon; empty for a block sequence item
+	Items    []string // Flow sequence items of the value, or the block sequence item
+}
+
+// IsItem reports whether the line is a block sequence item ("- a").
+func (l Line) IsItem() bool {
+	return l.Key == ""
+}
+
+// Scan splits data into its significant lines.
+func Scan(data []byte) ([]Line, error) {
+	var lines []Line
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		text := scanner.Text()
+		if idx := strings.Index(text, "#"); idx >= 0 {
+			text = text[:idx]
+		}
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" {
+			continue
+		}
+		indented := text[0] == ' ' || text[0] == '\t'
+
+		if item, ok := strings.CutPrefix(trimmed, "-"); ok {
+			line := Line{No: lineNo, Indented: indented}
+			if item = strings.TrimSpace(item); item != "" {
+				line.Items = []string{item}
+			}
+			lines = append(lines, line)
+			continue
+		}
+
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected \"key: value\"", lineNo)
+		}
+		lines = append(lines, Line{
+			No:       lineNo,
+			Indented: indented,
+			Key:      strings.TrimSpace(key),
+			Value:    strings.TrimSpace(value),
+			Items:    ParseFlowList(value),
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// ParseFlowList parses "[a, b]" or "a, b" into its items.
+func ParseFlowList(s string) []string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "[")
+	s = strings.TrimSuffix(s, "]")
+
+	var items []string
+	for part := range strings.SplitSeq(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			items = append(items, part)
+		}
+	}
+	return items
+}
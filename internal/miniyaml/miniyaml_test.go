@@ -0,0 +1,57 @@
+package miniyaml
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []Line
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			input: "",
+			want:  nil,
+		},
+		{
+			name:  "flow sequence",
+			input: "enable: [signal, timetick]\n",
+			want:  []Line{{No: 1, Key: "enable", Value: "[signal, timetick]", Items: []string{"signal", "timetick"}}},
+		},
+		{
+			name:  "nested block sequence",
+			input: "jobs:\n  spawners:\n    - jobs.Go\n",
+			want: []Line{
+				{No: 1, Key: "jobs"},
+				{No: 2, Indented: true, Key: "spawners"},
+				{No: 3, Indented: true, Items: []string{"jobs.Go"}},
+			},
+		},
+		{
+			name:  "comments and blank lines",
+			input: "# header\n\nenable: signal # trailing\n",
+			want:  []Line{{No: 3, Key: "enable", Value: "signal", Items: []string{"signal"}}},
+		},
+		{
+			name:    "not a mapping",
+			input:   "signal\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Scan([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -55,14 +55,13 @@ func (c *Context) FuncLitAssignedTo(v *types.Var, beforePos token.Pos) *ast.Func
 
 	var result *ast.FuncLit
 	ast.Inspect(f, func(n ast.Node) bool {
-		assign, ok := n.(*ast.AssignStmt)
-		if !ok {
+		if !isAssignment(n) {
 			return true
 		}
-		if beforePos != token.NoPos && assign.Pos() >= beforePos {
+		if beforePos != token.NoPos && n.Pos() >= beforePos {
 			return true
 		}
-		if fl := c.funcLitInAssignment(assign, v); fl != nil {
+		if fl := c.funcLitInAssignment(n, v); fl != nil {
 			result = fl
 		}
 		return true
@@ -83,14 +82,13 @@ func (c *Context) FuncLitsAssignedTo(v *types.Var, beforePos token.Pos) []*ast.F
 
 	var results []*ast.FuncLit
 	ast.Inspect(f, func(n ast.Node) bool {
-		assign, ok := n.(*ast.AssignStmt)
-		if !ok {
+		if !isAssignment(n) {
 			return true
 		}
-		if beforePos != token.NoPos && assign.Pos() >= beforePos {
+		if beforePos != token.NoPos && n.Pos() >= beforePos {
 			return true
 		}
-		if fl := c.funcLitInAssignment(assign, v); fl != nil {
+		if fl := c.funcLitInAssignment(n, v); fl != nil {
 			results = append(results, fl)
 		}
 		return true
@@ -109,15 +107,14 @@ func (c *Context) FuncLitAssignmentsTo(v *types.Var, beforePos token.Pos) []Func
 	var results []FuncLitAssignment
 	insp := inspector.New([]*ast.File{f})
 
-	insp.WithStack([]ast.Node{(*ast.AssignStmt)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+	insp.WithStack([]ast.Node{(*ast.AssignStmt)(nil), (*ast.ValueSpec)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
 		if !push {
 			return true
 		}
-		assign := n.(*ast.AssignStmt)
-		if beforePos != token.NoPos && assign.Pos() >= beforePos {
+		if beforePos != token.NoPos && n.Pos() >= beforePos {
 			return true
 		}
-		fl := c.funcLitInAssignment(assign, v)
+		fl := c.funcLitInAssignment(n, v)
 		if fl == nil {
 			return true
 		}
@@ -146,26 +143,68 @@ func isInControlStructure(stack []ast.Node) bool {
 	return false
 }
 
-// funcLitInAssignment checks if the assignment assigns a func literal to v.
-func (c *Context) funcLitInAssignment(assign *ast.AssignStmt, v *types.Var) *ast.FuncLit {
-	for i, lhs := range assign.Lhs {
-		ident, ok := lhs.(*ast.Ident)
+// isAssignment checks if the node is an assignment or a var declaration.
+func isAssignment(n ast.Node) bool {
+	switch n.(type) {
+	case *ast.AssignStmt, *ast.ValueSpec:
+		return true
+	}
+	return false
+}
+
+// funcLitInAssignment checks if the assignment or var declaration assigns
+// a func literal to v. Conversions to defined func types are unwrapped:
+//
+//	var t task = func() error { ... }
+//	t := task(func() error { ... })
+func (c *Context) funcLitInAssignment(n ast.Node, v *types.Var) *ast.FuncLit {
+	var lhs, rhs []ast.Expr
+	switch n := n.(type) {
+	case *ast.AssignStmt:
+		lhs, rhs = n.Lhs, n.Rhs
+	case *ast.ValueSpec:
+		for _, name := range n.Names {
+			lhs = append(lhs, name)
+		}
+		rhs = n.Values
+	}
+
+	for i, l := range lhs {
+		ident, ok := l.(*ast.Ident)
 		if !ok {
 			continue
 		}
 		if c.Pass.TypesInfo.ObjectOf(ident) != v {
 			continue
 		}
-		if i >= len(assign.Rhs) {
+		if i >= len(rhs) {
 			continue
 		}
-		if fl, ok := assign.Rhs[i].(*ast.FuncLit); ok {
+		if fl, ok := c.unwrapFuncConversion(rhs[i]).(*ast.FuncLit); ok {
 			return fl
 		}
 	}
 	return nil
 }
 
+// unwrapFuncConversion returns the operand of a conversion to a func type,
+// such as task(func() error { ... }), or expr itself otherwise.
+func (c *Context) unwrapFuncConversion(expr ast.Expr) ast.Expr {
+	expr = ast.Unparen(expr)
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return expr
+	}
+	tv, ok := c.Pass.TypesInfo.Types[call.Fun]
+	if !ok || !tv.IsType() {
+		return expr
+	}
+	if _, ok := tv.Type.Underlying().(*types.Signature); !ok {
+		return expr
+	}
+	return ast.Unparen(call.Args[0])
+}
+
 // CallExprAssignedToIdent is a convenience method that combines VarOf and CallExprAssignedTo.
 // Returns the last call expression assignment found.
 func (c *Context) CallExprAssignedToIdent(ident *ast.Ident) *ast.CallExpr {
@@ -220,3 +259,71 @@ func (c *Context) callExprInAssignment(assign *ast.AssignStmt, v *types.Var) *as
 	}
 	return nil
 }
+
+// CallResultAssignedTo searches for the last call expression whose result is
+// assigned to the variable before the given position, along with the result
+// index the variable receives. Handles "fn := f()", "var fn = f()" (index 0)
+// and tuple assignments like "a, fn := f()". Package-level variables are
+// initialized before any use, so their declaration counts wherever it appears.
+func (c *Context) CallResultAssignedTo(v *types.Var, beforePos token.Pos) (*ast.CallExpr, int) {
+	f := c.FileOf(v.Pos())
+	if f == nil {
+		return nil, 0
+	}
+	pkgLevel := v.Pkg() != nil && v.Parent() == v.Pkg().Scope()
+
+	var result *ast.CallExpr
+	var resultIdx int
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil || (beforePos != token.NoPos && n.Pos() >= beforePos && !pkgLevel) {
+			return true
+		}
+		var lhs, rhs []ast.Expr
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			lhs, rhs = n.Lhs, n.Rhs
+		case *ast.ValueSpec:
+			for _, name := range n.Names {
+				lhs = append(lhs, name)
+			}
+			rhs = n.Values
+		default:
+			return true
+		}
+		if call, idx := c.callResultInAssignment(lhs, rhs, v); call != nil {
+			result, resultIdx = call, idx
+		}
+		return true
+	})
+
+	return result, resultIdx
+}
+
+// callResultInAssignment checks if the assignment assigns a call result to v.
+func (c *Context) callResultInAssignment(lhs, rhs []ast.Expr, v *types.Var) (*ast.CallExpr, int) {
+	for i, expr := range lhs {
+		ident, ok := expr.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		if c.Pass.TypesInfo.ObjectOf(ident) != v {
+			continue
+		}
+
+		// Tuple assignment from a single multi-value call: a, fn := f()
+		if len(lhs) > 1 && len(rhs) == 1 {
+			if call, ok := rhs[0].(*ast.CallExpr); ok {
+				return call, i
+			}
+			continue
+		}
+
+		if i >= len(rhs) {
+			continue
+		}
+		if call, ok := rhs[i].(*ast.CallExpr); ok {
+			return call, 0
+		}
+	}
+	return nil, 0
+}
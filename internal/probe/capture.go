@@ -2,8 +2,10 @@ package probe
 
 import (
 	"go/ast"
+	"go/types"
 
 	"github.com/mpyw/goroutinectx/internal/directive/carrier"
+	"github.com/mpyw/goroutinectx/internal/funcspec"
 	"github.com/mpyw/goroutinectx/internal/typeutil"
 )
 
@@ -26,6 +28,22 @@ func (c *Context) FuncLitCapturesContextSSA(lit *ast.FuncLit) (bool, bool) {
 	return c.Tracer.ClosureCapturesContext(ssaFn, c.Carriers), true
 }
 
+// FuncLitReceivesStructContext checks if a function literal reads the context
+// field of a struct received from a captured channel:
+//
+//	go func() {
+//	    r := <-requests
+//	    handle(r.ctx)
+//	}()
+//
+// Always false unless StructFields is set.
+func (c *Context) FuncLitReceivesStructContext(lit *ast.FuncLit) bool {
+	if !c.StructFields || c.SSAProg == nil || c.Tracer == nil {
+		return false
+	}
+	return c.Tracer.ClosureReceivesStructContext(c.SSAProg.FindFuncLit(lit))
+}
+
 // FuncTypeHasContextParam checks if a function type has a context.Context parameter.
 func (c *Context) FuncTypeHasContextParam(fnType *ast.FuncType) bool {
 	if fnType == nil || fnType.Params == nil {
@@ -48,6 +66,84 @@ func (c *Context) FuncLitHasContextParam(lit *ast.FuncLit) bool {
 	return c.FuncTypeHasContextParam(lit.Type)
 }
 
+// FuncRefCapturesContext checks if a package-level function passed by name,
+// as in g.Go(work), can use the caller's context. Unlike a closure it cannot
+// capture the context, so only a context parameter counts.
+// Functions declared outside the analyzed package are assumed OK.
+func (c *Context) FuncRefCapturesContext(fn *types.Func) bool {
+	if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() != nil {
+		return true // Method expressions are not traced
+	}
+	funcDecl := c.FuncDeclOf(fn)
+	if funcDecl == nil {
+		return true
+	}
+	return c.FuncTypeHasContextParam(funcDecl.Type)
+}
+
+// MethodExprUsesContext checks a method expression passed as a func value,
+// as in iter.ForEach(workers, (*worker).run). The spawner supplies the
+// receiver, so the method can only see a context through a context
+// parameter or a context field read from the receiver it is given.
+// Returns (result, true) if sel is a method expression.
+// Methods declared outside the analyzed package are assumed OK.
+func (c *Context) MethodExprUsesContext(sel *ast.SelectorExpr) (bool, bool) {
+	selection := c.Pass.TypesInfo.Selections[sel]
+	if selection == nil || selection.Kind() != types.MethodExpr {
+		return false, false
+	}
+
+	fn, ok := selection.Obj().(*types.Func)
+	if !ok {
+		return true, true
+	}
+	decl := c.FuncDeclOf(fn)
+	if decl == nil || decl.Body == nil {
+		return true, true
+	}
+
+	if c.FuncTypeHasContextParam(decl.Type) {
+		return true, true
+	}
+	return c.readsContextField(decl.Body), true
+}
+
+// PhiFuncArgSources resolves the idx-th argument of call when its SSA value
+// merges several assignments, as in:
+//
+//	var fn func() error
+//	if fast { fn = quick } else { fn = slow }
+//	g.Go(fn)
+//
+// It returns the func literals and package-level functions reaching the call.
+// Returns false if SSA is unavailable, the argument is not merged, or any
+// merged value can't be traced back to its source.
+func (c *Context) PhiFuncArgSources(call *ast.CallExpr, idx int) ([]*ast.FuncLit, []*types.Func, bool) {
+	if c.SSAProg == nil || c.Tracer == nil {
+		return nil, nil, false
+	}
+
+	sources, ok := c.Tracer.PhiFuncSources(c.SSAProg.ArgAt(call, idx))
+	if !ok {
+		return nil, nil, false
+	}
+
+	var lits []*ast.FuncLit
+	var funcs []*types.Func
+	for _, fn := range sources {
+		if lit, ok := fn.Syntax().(*ast.FuncLit); ok {
+			lits = append(lits, lit)
+			continue
+		}
+		obj, ok := fn.Object().(*types.Func)
+		if !ok || fn.Parent() != nil {
+			return nil, nil, false // Wrappers such as bound methods are not traced
+		}
+		funcs = append(funcs, obj)
+	}
+	return lits, funcs, true
+}
+
 // FuncLitCapturesContext checks if a func literal captures context (AST-based).
 func (c *Context) FuncLitCapturesContext(lit *ast.FuncLit) bool {
 	return c.FuncLitHasContextParam(lit) || c.FuncLitUsesContext(lit)
@@ -88,12 +184,200 @@ func (c *Context) FuncLitsAllCaptureContext(assigns []FuncLitAssignment) bool {
 	return true
 }
 
+// maxDelegateDepth bounds how many levels of local closure calls
+// FuncLitCallsContextClosure follows.
+const maxDelegateDepth = 4
+
+// FuncLitCallsContextClosure checks if a function literal calls a local
+// closure that uses context, instead of referencing it directly:
+//
+//	help := func() error { return work(ctx) }
+//	g.Go(func() error { return help() })
+//
+// Chains of local closures are followed up to maxDelegateDepth levels.
+// Like FuncLitsAllCaptureContext, every assignment from the last
+// unconditional one onwards must use context.
+func (c *Context) FuncLitCallsContextClosure(lit *ast.FuncLit) bool {
+	return c.callsContextClosure(lit, map[*ast.FuncLit]bool{lit: true}, maxDelegateDepth)
+}
+
+// callsContextClosure implements FuncLitCallsContextClosure.
+// Closures already in visited are treated as not using context, so
+// mutually recursive closures terminate.
+func (c *Context) callsContextClosure(lit *ast.FuncLit, visited map[*ast.FuncLit]bool, depth int) bool {
+	if depth == 0 {
+		return false
+	}
+
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+		if !ok {
+			return true
+		}
+		assigns := c.FuncLitAssignmentsOfIdent(ident)
+		if len(assigns) == 0 {
+			return true
+		}
+
+		start := 0
+		for i := len(assigns) - 1; i >= 0; i-- {
+			if !assigns[i].Conditional {
+				start = i
+				break
+			}
+		}
+
+		for _, assign := range assigns[start:] {
+			if visited[assign.Lit] {
+				return true
+			}
+			visited[assign.Lit] = true
+			if !c.FuncLitUsesContext(assign.Lit) && !c.callsContextClosure(assign.Lit, visited, depth-1) {
+				return true
+			}
+		}
+		found = true
+		return false
+	})
+	return found
+}
+
+// FuncLitPropagatesContext checks if a function literal propagates context
+// without capturing it: through a local closure, a receiver or struct field,
+// a channel, or an accessor. The goroutine, spawn callback and spawner
+// checkers all accept it alongside a direct capture, so that the opt-in
+// flags enabling some of these checks apply to each of them.
+func (c *Context) FuncLitPropagatesContext(lit *ast.FuncLit) bool {
+	return c.FuncLitCallsContextClosure(lit) || c.FuncLitCallsContextFieldMethod(lit) ||
+		c.FuncLitUsesStructContext(lit) || c.FuncLitReceivesStructContext(lit) ||
+		c.FuncLitObtainsContext(lit) || c.FuncLitRunsContextFuncsFromChannel(lit)
+}
+
 // FuncLitUsesContext checks if a function literal references any context variable.
 // Does NOT descend into nested func literals.
 func (c *Context) FuncLitUsesContext(lit *ast.FuncLit) bool {
 	return c.nodeReferencesContext(lit.Body, true)
 }
 
+// FuncLitUsesStructContext checks if a function literal reads a context-typed
+// struct field (s.ctx) or calls a method reading one through its receiver
+// (s.doWork()). Always false unless StructFields is set.
+// Does NOT descend into nested func literals.
+func (c *Context) FuncLitUsesStructContext(lit *ast.FuncLit) bool {
+	if !c.StructFields {
+		return false
+	}
+
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if c.isContextField(sel.Sel) || c.MethodReadsContextField(sel) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// FuncLitObtainsContext checks if a function literal obtains its own context
+// from an accessor, a call returning context.Context that is not declared in
+// the context package, and does not discard it:
+//
+//	g.Go(func() error {
+//	    ctx := trace.ContextFromHeaders(h)
+//	    return work(ctx)
+//	})
+//
+// context.Background() and context.TODO() never count. Always false unless
+// Accessors is set.
+// Does NOT descend into nested func literals.
+func (c *Context) FuncLitObtainsContext(lit *ast.FuncLit) bool {
+	if !c.Accessors {
+		return false
+	}
+
+	discarded := make(map[*ast.CallExpr]bool)
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ExprStmt:
+			if call, ok := n.X.(*ast.CallExpr); ok {
+				discarded[call] = true
+			}
+		case *ast.CallExpr:
+			if !discarded[n] && c.isContextAccessorCall(n) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// isContextAccessorCall checks if a call returns context.Context from a
+// function declared outside the context package.
+func (c *Context) isContextAccessorCall(call *ast.CallExpr) bool {
+	if !typeutil.IsContextType(c.Pass.TypesInfo.TypeOf(call)) {
+		return false
+	}
+	fn := funcspec.ExtractFunc(c.Pass, call)
+	return fn != nil && fn.Pkg() != nil && fn.Pkg().Path() != "context"
+}
+
+// FuncLitPassesContext checks if a function literal passes a context
+// variable to a call, as an argument or as the receiver of a method call
+// such as ctx.Done(). Merely referencing it (_ = ctx) does not count.
+// DOES descend into nested func literals.
+func (c *Context) FuncLitPassesContext(lit *ast.FuncLit) bool {
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok && c.nodeReferencesContext(sel.X, true) {
+			found = true
+			return false
+		}
+		for _, arg := range call.Args {
+			if c.nodeReferencesContext(arg, true) {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+	return found
+}
+
 // ArgUsesContext checks if an expression references a context variable.
 // Unlike FuncLitUsesContext, this DOES descend into nested func literals.
 func (c *Context) ArgUsesContext(expr ast.Expr) bool {
@@ -0,0 +1,174 @@
+package probe
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// FuncLitRunsContextFuncsFromChannel checks if a function literal invokes
+// funcs received from a channel that is only ever fed func literals using
+// context:
+//
+//	jobs := make(chan func())
+//	go func() {
+//	    for job := range jobs {
+//	        job()
+//	    }
+//	}()
+//	jobs <- func() { doWork(ctx) }
+//
+// The channel must be a local variable used only by sends, receives, range
+// statements and close, so that no other func can reach it.
+// Does NOT descend into nested func literals.
+func (c *Context) FuncLitRunsContextFuncsFromChannel(lit *ast.FuncLit) bool {
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if ch := c.channelOfCallee(call.Fun); ch != nil && c.channelFedContextFuncs(ch) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// channelOfCallee returns the channel variable a called func value was
+// received from, either directly ((<-jobs)()), through a range statement
+// (for job := range jobs) or through an assignment (job := <-jobs).
+func (c *Context) channelOfCallee(fun ast.Expr) *types.Var {
+	fun = ast.Unparen(fun)
+	if recv := channelRecvOf(fun); recv != nil {
+		return c.localChannelVar(recv)
+	}
+
+	ident, ok := fun.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	v := c.VarOf(ident)
+	if v == nil {
+		return nil
+	}
+	f := c.FileOf(v.Pos())
+	if f == nil {
+		return nil
+	}
+
+	var result *types.Var
+	ast.Inspect(f, func(n ast.Node) bool {
+		if result != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.RangeStmt:
+			if key, ok := n.Key.(*ast.Ident); ok && c.Pass.TypesInfo.Defs[key] == v {
+				if _, ok := c.Pass.TypesInfo.TypeOf(n.X).Underlying().(*types.Chan); ok {
+					result = c.localChannelVar(n.X)
+				}
+			}
+		case *ast.AssignStmt:
+			if len(n.Rhs) != 1 || len(n.Lhs) == 0 {
+				return true
+			}
+			if key, ok := n.Lhs[0].(*ast.Ident); ok && c.Pass.TypesInfo.ObjectOf(key) == v {
+				if recv := channelRecvOf(n.Rhs[0]); recv != nil {
+					result = c.localChannelVar(recv)
+				}
+			}
+		}
+		return result == nil
+	})
+	return result
+}
+
+// channelRecvOf returns the channel operand of a receive expression, or nil.
+func channelRecvOf(expr ast.Expr) ast.Expr {
+	unary, ok := ast.Unparen(expr).(*ast.UnaryExpr)
+	if !ok || unary.Op != token.ARROW {
+		return nil
+	}
+	return unary.X
+}
+
+// localChannelVar returns the function-local variable a channel expression
+// refers to, or nil.
+func (c *Context) localChannelVar(expr ast.Expr) *types.Var {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	v := c.VarOf(ident)
+	if v == nil || v.Parent() == nil || v.Parent() == v.Pkg().Scope() {
+		return nil
+	}
+	return v
+}
+
+// channelFedContextFuncs checks if every send to ch is a func literal using
+// context, at least one send exists, and ch is not used in any other way
+// than sending, receiving, ranging and closing.
+func (c *Context) channelFedContextFuncs(ch *types.Var) bool {
+	f := c.FileOf(ch.Pos())
+	if f == nil {
+		return false
+	}
+
+	allowed := make(map[*ast.Ident]bool)
+	sends := 0
+	ok := true
+
+	allow := func(expr ast.Expr) {
+		if ident, isIdent := ast.Unparen(expr).(*ast.Ident); isIdent && c.Pass.TypesInfo.Uses[ident] == ch {
+			allowed[ident] = true
+		}
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SendStmt:
+			ident, isIdent := ast.Unparen(n.Chan).(*ast.Ident)
+			if !isIdent || c.Pass.TypesInfo.Uses[ident] != ch {
+				return true
+			}
+			allowed[ident] = true
+			sends++
+			lit, isLit := ast.Unparen(n.Value).(*ast.FuncLit)
+			if !isLit || !c.FuncLitUsesContext(lit) {
+				ok = false
+			}
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				allow(n.X)
+			}
+		case *ast.RangeStmt:
+			allow(n.X)
+		case *ast.CallExpr:
+			if fn, isIdent := ast.Unparen(n.Fun).(*ast.Ident); isIdent && len(n.Args) == 1 {
+				if b, isBuiltin := c.Pass.TypesInfo.Uses[fn].(*types.Builtin); isBuiltin && b.Name() == "close" {
+					allow(n.Args[0])
+				}
+			}
+		}
+		return true
+	})
+	if !ok || sends == 0 {
+		return false
+	}
+
+	for ident, obj := range c.Pass.TypesInfo.Uses {
+		if obj == ch && !allowed[ident] {
+			return false
+		}
+	}
+	return true
+}
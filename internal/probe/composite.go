@@ -6,10 +6,36 @@ import (
 	"go/token"
 	"go/types"
 	"strings"
+
+	"github.com/mpyw/goroutinectx/internal/typeutil"
 )
 
 // SelectorExprCapturesContext checks if a struct field func captures context.
+// The struct may be a local variable, an inline composite literal
+// (task{fn: func() {...}}.fn) or the result of a factory call (newJob(ctx).run),
+// either directly or through a variable it was assigned to.
+// Methods on type parameters are resolved via TypeParamMethodUsesContext,
+// and method expressions via MethodExprUsesContext.
 func (c *Context) SelectorExprCapturesContext(sel *ast.SelectorExpr) bool {
+	if result, ok := c.TypeParamMethodUsesContext(sel); ok {
+		return result
+	}
+	if result, ok := c.MethodExprUsesContext(sel); ok {
+		return result
+	}
+
+	if compLit := compositeLitOf(sel.X); compLit != nil {
+		funcLit := funcLitOfField(compLit, sel.Sel.Name)
+		if funcLit == nil {
+			return true
+		}
+		return c.FuncLitUsesContext(funcLit)
+	}
+
+	if call, ok := sel.X.(*ast.CallExpr); ok {
+		return c.FactoryCallFieldUsesContext(call, sel.Sel.Name)
+	}
+
 	ident, ok := sel.X.(*ast.Ident)
 	if !ok {
 		return true
@@ -23,6 +49,10 @@ func (c *Context) SelectorExprCapturesContext(sel *ast.SelectorExpr) bool {
 	fieldName := sel.Sel.Name
 	funcLit := c.FuncLitOfStructField(v, fieldName)
 	if funcLit == nil {
+		// h := newHolder(); g.Go(h.task)
+		if call, idx := c.CallResultAssignedTo(v, sel.Pos()); call != nil && idx == 0 {
+			return c.FactoryCallFieldUsesContext(call, fieldName)
+		}
 		return true
 	}
 
@@ -49,6 +79,121 @@ func (c *Context) IndexExprCapturesContext(idx *ast.IndexExpr) bool {
 	return c.FuncLitUsesContext(funcLit)
 }
 
+// RangeValueFuncLits returns the func literal elements of the container that
+// a range value variable iterates over:
+//
+//	handlers := map[string]func() error{"a": func() error { ... }}
+//	for _, fn := range handlers {
+//	    g.Go(fn)
+//	}
+//
+// Elements that aren't func literals are skipped. Returns nil if ident isn't
+// the value of a range statement over a traceable composite literal.
+func (c *Context) RangeValueFuncLits(ident *ast.Ident) []*ast.FuncLit {
+	v := c.VarOf(ident)
+	if v == nil {
+		return nil
+	}
+
+	rng := c.rangeStmtOfValue(v)
+	if rng == nil {
+		return nil
+	}
+
+	compLit := compositeLitOf(rng.X)
+	if compLit == nil {
+		x, ok := ast.Unparen(rng.X).(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		xv := c.VarOf(x)
+		if xv == nil {
+			return nil
+		}
+		compLit = c.compositeLitAssignedTo(xv, rng.Pos())
+		if compLit == nil {
+			return nil
+		}
+	}
+
+	var result []*ast.FuncLit
+	for _, elt := range compLit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			elt = kv.Value
+		}
+		if fl, ok := ast.Unparen(elt).(*ast.FuncLit); ok {
+			result = append(result, fl)
+		}
+	}
+	return result
+}
+
+// rangeStmtOfValue finds the range statement declaring v as its value variable.
+func (c *Context) rangeStmtOfValue(v *types.Var) *ast.RangeStmt {
+	f := c.FileOf(v.Pos())
+	if f == nil {
+		return nil
+	}
+
+	var result *ast.RangeStmt
+	ast.Inspect(f, func(n ast.Node) bool {
+		if result != nil {
+			return false
+		}
+		rng, ok := n.(*ast.RangeStmt)
+		if !ok || rng.Tok != token.DEFINE {
+			return true
+		}
+		if value, ok := rng.Value.(*ast.Ident); ok && c.Pass.TypesInfo.Defs[value] == v {
+			result = rng
+		}
+		return result == nil
+	})
+
+	return result
+}
+
+// compositeLitAssignedTo returns the last composite literal assigned to v
+// before the given position.
+func (c *Context) compositeLitAssignedTo(v *types.Var, beforePos token.Pos) *ast.CompositeLit {
+	f := c.FileOf(v.Pos())
+	if f == nil {
+		return nil
+	}
+
+	var result *ast.CompositeLit
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil || n.Pos() >= beforePos {
+			return true
+		}
+		var lhs []*ast.Ident
+		var rhs []ast.Expr
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, expr := range n.Lhs {
+				ident, _ := expr.(*ast.Ident)
+				lhs = append(lhs, ident)
+			}
+			rhs = n.Rhs
+		case *ast.ValueSpec:
+			lhs, rhs = n.Names, n.Values
+		default:
+			return true
+		}
+		if len(lhs) != len(rhs) {
+			return true
+		}
+		for i, ident := range lhs {
+			if ident != nil && c.Pass.TypesInfo.ObjectOf(ident) == v {
+				result = compositeLitOf(rhs[i])
+			}
+		}
+		return true
+	})
+
+	return result
+}
+
 // FuncLitOfStructField finds a func literal assigned to a struct field.
 func (c *Context) FuncLitOfStructField(v *types.Var, fieldName string) *ast.FuncLit {
 	f := c.FileOf(v.Pos())
@@ -112,23 +257,42 @@ func (c *Context) funcLitOfFieldAssignment(assign *ast.AssignStmt, v *types.Var,
 		if !ok {
 			continue
 		}
-		for _, elt := range compLit.Elts {
-			kv, ok := elt.(*ast.KeyValueExpr)
-			if !ok {
-				continue
-			}
-			key, ok := kv.Key.(*ast.Ident)
-			if !ok || key.Name != fieldName {
-				continue
-			}
-			if fl, ok := kv.Value.(*ast.FuncLit); ok {
-				return fl
-			}
+		if fl := funcLitOfField(compLit, fieldName); fl != nil {
+			return fl
 		}
 	}
 	return nil
 }
 
+// funcLitOfField extracts the func literal of a keyed field from a struct composite literal.
+func funcLitOfField(compLit *ast.CompositeLit, fieldName string) *ast.FuncLit {
+	for _, elt := range compLit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok || key.Name != fieldName {
+			continue
+		}
+		if fl, ok := kv.Value.(*ast.FuncLit); ok {
+			return fl
+		}
+	}
+	return nil
+}
+
+// compositeLitOf returns the composite literal of a (possibly parenthesized
+// or address-taken) expression, or nil.
+func compositeLitOf(expr ast.Expr) *ast.CompositeLit {
+	expr = ast.Unparen(expr)
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = ast.Unparen(unary.X)
+	}
+	compLit, _ := expr.(*ast.CompositeLit)
+	return compLit
+}
+
 // funcLitOfIndexAssignment extracts a func literal at a specific index from an assignment.
 func (c *Context) funcLitOfIndexAssignment(assign *ast.AssignStmt, v *types.Var, indexExpr ast.Expr) *ast.FuncLit {
 	for i, lhs := range assign.Lhs {
@@ -189,3 +353,148 @@ func funcLitOfLiteralKey(compLit *ast.CompositeLit, lit *ast.BasicLit) *ast.Func
 
 	return nil
 }
+
+// FuncLitCallsContextFieldMethod checks if a func literal calls a method whose
+// receiver was initialized with a context in a struct field, and whose body
+// references that field:
+//
+//	w := &worker{ctx: ctx}
+//	go func() { w.run() }() // run uses w.ctx
+func (c *Context) FuncLitCallsContextFieldMethod(lit *ast.FuncLit) bool {
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && c.MethodUsesContextField(sel) {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+// MethodUsesContextField checks if sel is a method on a variable whose
+// context-typed field was set from a context in scope, and whose body
+// references that field through the receiver.
+func (c *Context) MethodUsesContextField(sel *ast.SelectorExpr) bool {
+	ident, ok := ast.Unparen(sel.X).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	v := c.VarOf(ident)
+	if v == nil {
+		return false
+	}
+
+	read := c.contextFieldsReadBy(sel)
+	if len(read) == 0 {
+		return false
+	}
+
+	for name := range c.contextFieldsOf(v) {
+		if read[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// MethodReadsContextField checks if sel is a method whose body references a
+// context-typed field through its receiver, regardless of how it was set.
+func (c *Context) MethodReadsContextField(sel *ast.SelectorExpr) bool {
+	return len(c.contextFieldsReadBy(sel)) > 0
+}
+
+// contextFieldsReadBy returns the names of context-typed receiver fields
+// referenced in the body of the method selected by sel.
+func (c *Context) contextFieldsReadBy(sel *ast.SelectorExpr) map[string]bool {
+	fn, ok := c.Pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok {
+		return nil
+	}
+	decl := c.FuncDeclOf(fn)
+	if decl == nil || decl.Body == nil || decl.Recv == nil || len(decl.Recv.List) == 0 || len(decl.Recv.List[0].Names) == 0 {
+		return nil
+	}
+	recv := c.Pass.TypesInfo.Defs[decl.Recv.List[0].Names[0]]
+	if recv == nil {
+		return nil
+	}
+
+	fields := make(map[string]bool)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		fieldSel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		x, ok := ast.Unparen(fieldSel.X).(*ast.Ident)
+		if ok && c.Pass.TypesInfo.ObjectOf(x) == recv && c.isContextField(fieldSel.Sel) {
+			fields[fieldSel.Sel.Name] = true
+		}
+		return true
+	})
+	return fields
+}
+
+// contextFieldsOf returns the names of context-typed fields of v that are set
+// from a context in scope, either in a keyed composite literal assigned to v
+// or by a direct "v.field = ctx" assignment.
+func (c *Context) contextFieldsOf(v *types.Var) map[string]bool {
+	f := c.FileOf(v.Pos())
+	if f == nil {
+		return nil
+	}
+
+	fields := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != len(assign.Rhs) {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			switch lhs := lhs.(type) {
+			case *ast.Ident:
+				if c.Pass.TypesInfo.ObjectOf(lhs) == v {
+					c.collectContextFields(assign.Rhs[i], fields)
+				}
+			case *ast.SelectorExpr:
+				x, ok := lhs.X.(*ast.Ident)
+				if ok && c.Pass.TypesInfo.ObjectOf(x) == v && c.isContextField(lhs.Sel) && c.ArgUsesContext(assign.Rhs[i]) {
+					fields[lhs.Sel.Name] = true
+				}
+			}
+		}
+		return true
+	})
+	return fields
+}
+
+// collectContextFields adds the context-typed fields set from a context in
+// scope by a (possibly address-taken) keyed composite literal.
+func (c *Context) collectContextFields(expr ast.Expr, fields map[string]bool) {
+	compLit := compositeLitOf(expr)
+	if compLit == nil {
+		return
+	}
+	for _, elt := range compLit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if ok && c.isContextField(key) && c.ArgUsesContext(kv.Value) {
+			fields[key.Name] = true
+		}
+	}
+}
+
+// isContextField checks if the identifier refers to a context-typed struct field.
+func (c *Context) isContextField(ident *ast.Ident) bool {
+	field, ok := c.Pass.TypesInfo.ObjectOf(ident).(*types.Var)
+	return ok && field.IsField() && typeutil.IsContextType(field.Type())
+}
@@ -2,6 +2,7 @@ package probe
 
 import (
 	"go/ast"
+	"go/parser"
 	"go/token"
 	"go/types"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/mpyw/goroutinectx/internal/directive/carrier"
 	"github.com/mpyw/goroutinectx/internal/ssa"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
 )
 
 // Context provides context for pattern checking.
@@ -18,6 +20,24 @@ type Context struct {
 	SSAProg  *ssa.Program
 	CtxNames []string
 	Carriers []carrier.Carrier
+
+	// CtxDeclPos is the declaration of the first context in CtxNames.
+	CtxDeclPos token.Pos
+
+	// StructFields counts reads of context-typed struct fields, directly or
+	// through methods, as context usage (-track-struct-ctx-fields).
+	StructFields bool
+
+	// Accessors counts contexts obtained inside a closure from functions
+	// outside the context package as context usage (-recognize-context-accessors).
+	Accessors bool
+
+	// VerboseMessages names every context in CtxNames in diagnostics
+	// instead of the first one (-verbose-messages).
+	VerboseMessages bool
+
+	// Strict is set inside functions marked with //goroutinectx:strict.
+	Strict bool
 }
 
 // VarOf extracts *types.Var from an identifier.
@@ -59,3 +79,25 @@ func (c *Context) FuncDeclOf(fn *types.Func) *ast.FuncDecl {
 	}
 	return nil
 }
+
+// ContextExprAt parses the first context in CtxNames and type-checks it at
+// pos. It returns false unless the expression resolves to a
+// context.Context there, so that suggested fixes never pass a carrier or
+// a name that is out of scope.
+func (c *Context) ContextExprAt(pos token.Pos) (ast.Expr, bool) {
+	if len(c.CtxNames) == 0 {
+		return nil, false
+	}
+	expr, err := parser.ParseExpr(c.CtxNames[0])
+	if err != nil {
+		return nil, false
+	}
+	info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue)}
+	if err := types.CheckExpr(c.Pass.Fset, c.Pass.Pkg, pos, expr, info); err != nil {
+		return nil, false
+	}
+	if !typeutil.IsContextType(info.Types[expr].Type) {
+		return nil, false
+	}
+	return expr, true
+}
@@ -24,10 +24,22 @@
 //	│                      Analysis Method Categories                      │
 //	├──────────────────────┬──────────────────────────────────────────────┤
 //	│ Context Capture      │ FuncLitCapturesContext, FuncLitUsesContext   │
+//	│                      │ FuncLitPassesContext                         │
+//	│                      │ FuncLitCallsContextClosure                   │
+//	│                      │ FuncLitPropagatesContext                     │
 //	│ Parameter Detection  │ FuncLitHasContextParam, FuncTypeHasContextParam│
 //	│ Factory Functions    │ FactoryCallReturnsContextUsingFunc           │
+//	│                      │ FactoryCallFieldUsesContext                  │
 //	│ Variable Resolution  │ FuncLitOfIdent                               │
+//	│                      │ RangeValueFuncLits                           │
+//	│ Type Parameters      │ TypeParamMethodUsesContext                   │
+//	│ Method Expressions   │ MethodExprUsesContext                        │
+//	│ Receiver Fields      │ FuncLitCallsContextFieldMethod               │
+//	│ Channels             │ FuncLitRunsContextFuncsFromChannel           │
+//	│                      │ FuncLitReceivesStructContext                 │
+//	│ Accessors            │ FuncLitObtainsContext                        │
 //	│ SSA Analysis         │ FuncLitCapturesContextSSA                    │
+//	│                      │ PhiFuncArgSources                            │
 //	└──────────────────────┴──────────────────────────────────────────────┘
 //
 // # SSA vs AST Analysis
@@ -65,6 +77,20 @@
 //	// Factory capture - closure returned by factory that receives context
 //	go makeWorker(ctx)()  // factory receives context
 //
+//	// Receiver field capture - method reads a ctx field set from scope
+//	w := &worker{ctx: ctx}
+//	go func() {
+//	    w.run()  // run uses w.ctx
+//	}()
+//
+//	// Channel capture - runs funcs from a local channel only fed ctx-using literals
+//	go func() {
+//	    for job := range jobs {
+//	        job()
+//	    }
+//	}()
+//	jobs <- func() { doWork(ctx) }
+//
 // # Carrier Types
 //
 // Beyond context.Context, the analyzer supports custom carrier types configured
@@ -10,14 +10,46 @@ import (
 // return functions that use context.
 // Only checks what's actually returned, not all nested func literals.
 func (c *Context) BlockReturnsContextUsingFunc(body *ast.BlockStmt, excludeFuncLit *ast.FuncLit) bool {
+	return c.blockReturnsMatch(body, excludeFuncLit, func(results []ast.Expr) bool {
+		for _, result := range results {
+			if c.returnedValueUsesContext(result) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// BlockReturnsContextUsingFuncAt is like BlockReturnsContextUsingFunc but only
+// checks the returned value at the given result index.
+func (c *Context) BlockReturnsContextUsingFuncAt(body *ast.BlockStmt, excludeFuncLit *ast.FuncLit, idx int) bool {
+	return c.blockReturnsMatch(body, excludeFuncLit, func(results []ast.Expr) bool {
+		if idx >= len(results) {
+			return true // Bare return or forwarded tuple (return f()), can't analyze
+		}
+		switch result := results[idx].(type) {
+		case *ast.FuncLit:
+			return c.returnedValueUsesContext(result)
+		case *ast.Ident:
+			if len(c.FuncLitAssignmentsOfIdent(result)) == 0 {
+				return true // Not a traceable closure, assume OK
+			}
+			return c.returnedValueUsesContext(result)
+		}
+		return true // Can't analyze, assume OK
+	})
+}
+
+// blockReturnsMatch checks if any return statement in the block satisfies match.
+func (c *Context) blockReturnsMatch(body *ast.BlockStmt, excludeFuncLit *ast.FuncLit, match func(results []ast.Expr) bool) bool {
 	if body == nil {
 		return true
 	}
 
-	usesContext := false
+	found := false
 
 	ast.Inspect(body, func(n ast.Node) bool {
-		if usesContext {
+		if found {
 			return false
 		}
 		// Skip descending into nested func literals (except excludeFuncLit which is the factory itself)
@@ -30,16 +62,14 @@ func (c *Context) BlockReturnsContextUsingFunc(body *ast.BlockStmt, excludeFuncL
 			return true
 		}
 
-		for _, result := range ret.Results {
-			if c.returnedValueUsesContext(result) {
-				usesContext = true
-				return false
-			}
+		if match(ret.Results) {
+			found = true
+			return false
 		}
 		return true
 	})
 
-	return usesContext
+	return found
 }
 
 // FactoryReturnsContextUsingFunc checks if a factory FuncLit's return statements
@@ -104,6 +134,140 @@ func (c *Context) IdentFactoryReturnsContextUsingFunc(ident *ast.Ident) bool {
 	return true
 }
 
+// FactoryResultOfIdentUsesContext checks if an identifier assigned from a factory
+// call holds a context-using func, resolving which returned value it receives.
+// For example, in "worker, fn := build()" fn corresponds to the second result.
+// Only the last assignment before the identifier is used is considered.
+func (c *Context) FactoryResultOfIdentUsesContext(ident *ast.Ident) bool {
+	v := c.VarOf(ident)
+	if v == nil {
+		return true
+	}
+
+	call, idx := c.CallResultAssignedTo(v, ident.Pos())
+	if call == nil {
+		return true // Not assigned from a call, can't analyze
+	}
+
+	return c.FactoryCallResultUsesContext(call, idx)
+}
+
+// FactoryCallResultUsesContext checks if the factory call's result at idx
+// is a context-using func.
+func (c *Context) FactoryCallResultUsesContext(call *ast.CallExpr, idx int) bool {
+	if c.ArgsUseContext(call.Args) {
+		return true
+	}
+
+	switch fun := call.Fun.(type) {
+	case *ast.FuncLit:
+		if c.FuncLitHasContextParam(fun) {
+			return true
+		}
+		return c.BlockReturnsContextUsingFuncAt(fun.Body, fun, idx)
+
+	case *ast.Ident:
+		obj := c.Pass.TypesInfo.ObjectOf(fun)
+		if obj == nil {
+			return true
+		}
+
+		if v := c.VarOf(fun); v != nil {
+			funcLit := c.FuncLitAssignedTo(v, token.NoPos)
+			if funcLit == nil {
+				return true
+			}
+			if c.FuncLitHasContextParam(funcLit) {
+				return true
+			}
+			return c.BlockReturnsContextUsingFuncAt(funcLit.Body, funcLit, idx)
+		}
+
+		if fn, ok := obj.(*types.Func); ok {
+			funcDecl := c.FuncDeclOf(fn)
+			if funcDecl == nil {
+				return true
+			}
+			if c.FuncTypeHasContextParam(funcDecl.Type) {
+				return true
+			}
+			return c.BlockReturnsContextUsingFuncAt(funcDecl.Body, nil, idx)
+		}
+	}
+
+	return true // Can't analyze, assume OK
+}
+
+// FactoryCallFieldUsesContext checks if the struct returned by a factory call
+// holds a context-using func in the given field:
+//
+//	func newJob(ctx context.Context) job {
+//	    return job{run: func() error { _ = ctx; return nil }}
+//	}
+//	g.Go(newJob(ctx).run)
+//
+// Returns that aren't direct composite literals are assumed OK.
+func (c *Context) FactoryCallFieldUsesContext(call *ast.CallExpr, fieldName string) bool {
+	if c.ArgsUseContext(call.Args) {
+		return true
+	}
+
+	var body *ast.BlockStmt
+	var excludeFuncLit *ast.FuncLit
+
+	switch fun := call.Fun.(type) {
+	case *ast.FuncLit:
+		if c.FuncLitHasContextParam(fun) {
+			return true
+		}
+		body, excludeFuncLit = fun.Body, fun
+
+	case *ast.Ident:
+		if v := c.VarOf(fun); v != nil {
+			funcLit := c.FuncLitAssignedTo(v, token.NoPos)
+			if funcLit == nil {
+				return true
+			}
+			if c.FuncLitHasContextParam(funcLit) {
+				return true
+			}
+			body, excludeFuncLit = funcLit.Body, funcLit
+			break
+		}
+
+		fn, ok := c.Pass.TypesInfo.ObjectOf(fun).(*types.Func)
+		if !ok {
+			return true
+		}
+		funcDecl := c.FuncDeclOf(fn)
+		if funcDecl == nil {
+			return true
+		}
+		if c.FuncTypeHasContextParam(funcDecl.Type) {
+			return true
+		}
+		body = funcDecl.Body
+
+	default:
+		return true // Can't analyze, assume OK
+	}
+
+	return c.blockReturnsMatch(body, excludeFuncLit, func(results []ast.Expr) bool {
+		if len(results) != 1 {
+			return true // Bare return or multiple results, can't analyze
+		}
+		compLit := compositeLitOf(results[0])
+		if compLit == nil {
+			return true // Not a composite literal, assume OK
+		}
+		funcLit := funcLitOfField(compLit, fieldName)
+		if funcLit == nil {
+			return true
+		}
+		return c.FuncLitUsesContext(funcLit)
+	})
+}
+
 // returnedValueUsesContext checks if a returned value is a func that uses context.
 // For identifiers, checks ALL assignments from last unconditional onwards.
 func (c *Context) returnedValueUsesContext(result ast.Expr) bool {
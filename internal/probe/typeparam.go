@@ -0,0 +1,97 @@
+package probe
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// TypeParamMethodUsesContext checks a method selected on a type parameter,
+// as in g.Go(r.Run) where r has type R constrained by an interface.
+// Returns (result, true) if the receiver is a type parameter.
+//
+// The check is best-effort: when exactly one concrete type in the package
+// satisfies the constraint, the method body must read a context.Context
+// field. Otherwise the method is assumed OK.
+func (c *Context) TypeParamMethodUsesContext(sel *ast.SelectorExpr) (bool, bool) {
+	tp, ok := c.Pass.TypesInfo.TypeOf(sel.X).(*types.TypeParam)
+	if !ok {
+		return false, false
+	}
+
+	impl := c.soleImplementor(tp)
+	if impl == nil {
+		return true, true // Zero or several candidates, can't analyze
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(impl, true, c.Pass.Pkg, sel.Sel.Name)
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return true, true
+	}
+
+	decl := c.FuncDeclOf(fn)
+	if decl == nil || decl.Body == nil {
+		return true, true
+	}
+
+	return c.readsContextField(decl.Body), true
+}
+
+// soleImplementor returns the only package-level concrete type satisfying the
+// type parameter's constraint, trying both T and *T.
+func (c *Context) soleImplementor(tp *types.TypeParam) types.Type {
+	iface, ok := tp.Constraint().Underlying().(*types.Interface)
+	if !ok || iface.NumMethods() == 0 {
+		return nil
+	}
+
+	var found types.Type
+
+	scope := c.Pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() {
+			continue
+		}
+		named, ok := tn.Type().(*types.Named)
+		if !ok || named.TypeParams().Len() > 0 || types.IsInterface(named) {
+			continue
+		}
+
+		for _, candidate := range []types.Type{named, types.NewPointer(named)} {
+			if !types.Satisfies(candidate, iface) {
+				continue
+			}
+			if found != nil {
+				return nil // Ambiguous
+			}
+			found = candidate
+			break
+		}
+	}
+
+	return found
+}
+
+// readsContextField checks if the body selects a context.Context field.
+func (c *Context) readsContextField(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		selection := c.Pass.TypesInfo.Selections[sel]
+		if selection != nil && selection.Kind() == types.FieldVal && typeutil.IsContextType(selection.Type()) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
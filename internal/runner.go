@@ -3,12 +3,14 @@ package internal
 import (
 	"go/ast"
 	"go/token"
+	"slices"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/ast/inspector"
 
 	"github.com/mpyw/goroutinectx/internal/directive/carrier"
 	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+	"github.com/mpyw/goroutinectx/internal/directive/strict"
 	"github.com/mpyw/goroutinectx/internal/probe"
 	"github.com/mpyw/goroutinectx/internal/scope"
 	"github.com/mpyw/goroutinectx/internal/ssa"
@@ -18,37 +20,51 @@ import (
 type Runner struct {
 	goStmtCheckers []GoStmtChecker
 	callCheckers   []CallChecker
+	nodeCheckers   []NodeChecker
 	ssaProg        *ssa.Program
 	tracer         *ssa.Tracer
-	carriers       []carrier.Carrier
-	ignoreMaps     map[string]ignore.Map
-	skipFiles      map[string]bool
+	opts           RunnerOptions
+}
+
+// RunnerOptions configures how a Runner finds context scopes and which
+// diagnostics it reports.
+type RunnerOptions struct {
+	Carriers      []carrier.Carrier                 // Context carrier types from -context-carriers
+	IgnoreMaps    map[string]ignore.Map             // Ignore directives by filename
+	SkipFiles     map[string]bool                   // Files ignored entirely
+	FileEnabled   map[string]ignore.EnabledCheckers // Checkers enabled by directory configs, by filename
+	ReceiverCtx   bool                              // Give methods a scope from receiver struct context fields
+	StructFields  bool                              // Count reads of struct context fields as context use
+	Accessors     bool                              // Count context accessor calls as context use
+	Verbose       bool                              // Name every context in scope in messages
+	StrictFuncs   strict.Set                        // Functions marked //goroutinectx:strict
+	ExportedOnly  bool                              // Check only exported top-level functions
+	LocalCtx      bool                              // Treat local context variables as in scope
+	MinConfidence Confidence                        // Drop failures below this confidence
 }
 
 // NewRunner creates a new runner.
 func NewRunner(
 	goStmtCheckers []GoStmtChecker,
 	callCheckers []CallChecker,
+	nodeCheckers []NodeChecker,
 	ssaProg *ssa.Program,
-	carriers []carrier.Carrier,
-	ignoreMaps map[string]ignore.Map,
-	skipFiles map[string]bool,
+	opts RunnerOptions,
 ) *Runner {
 	return &Runner{
 		goStmtCheckers: goStmtCheckers,
 		callCheckers:   callCheckers,
+		nodeCheckers:   nodeCheckers,
 		ssaProg:        ssaProg,
 		tracer:         ssa.NewTracer(),
-		carriers:       carriers,
-		ignoreMaps:     ignoreMaps,
-		skipFiles:      skipFiles,
+		opts:           opts,
 	}
 }
 
 // Run executes all checkers on the pass.
 func (r *Runner) Run(pass *analysis.Pass, insp *inspector.Inspector) {
 	// Build context scopes for functions with context parameters
-	funcScopes := scope.Build(pass, insp, r.carriers)
+	funcScopes := scope.Build(pass, insp, r.opts.Carriers, r.opts.ReceiverCtx, r.opts.ExportedOnly, r.opts.LocalCtx)
 
 	// Node types we're interested in
 	nodeFilter := []ast.Node{
@@ -57,6 +73,9 @@ func (r *Runner) Run(pass *analysis.Pass, insp *inspector.Inspector) {
 		(*ast.GoStmt)(nil),
 		(*ast.CallExpr)(nil),
 	}
+	for _, checker := range r.nodeCheckers {
+		nodeFilter = append(nodeFilter, checker.NodeTypes()...)
+	}
 
 	// Check nodes within context-aware functions
 	insp.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
@@ -65,7 +84,7 @@ func (r *Runner) Run(pass *analysis.Pass, insp *inspector.Inspector) {
 		}
 
 		filename := pass.Fset.Position(n.Pos()).Filename
-		if r.skipFiles[filename] {
+		if r.opts.SkipFiles[filename] {
 			return true
 		}
 
@@ -74,12 +93,22 @@ func (r *Runner) Run(pass *analysis.Pass, insp *inspector.Inspector) {
 			return true // No context in scope
 		}
 
+		carriers := r.opts.Carriers
+		if len(s.Carriers) > 0 {
+			carriers = append(slices.Clip(r.opts.Carriers), s.Carriers...)
+		}
+
 		cctx := &probe.Context{
-			Pass:     pass,
-			Tracer:   r.tracer,
-			SSAProg:  r.ssaProg,
-			CtxNames: s.CtxNames,
-			Carriers: r.carriers,
+			Pass:            pass,
+			Tracer:          r.tracer,
+			SSAProg:         r.ssaProg,
+			CtxNames:        s.CtxNames,
+			Carriers:        carriers,
+			CtxDeclPos:      s.DeclPos,
+			StructFields:    r.opts.StructFields,
+			Accessors:       r.opts.Accessors,
+			VerboseMessages: r.opts.Verbose,
+			Strict:          r.opts.StrictFuncs.Encloses(stack),
 		}
 
 		switch node := n.(type) {
@@ -87,6 +116,10 @@ func (r *Runner) Run(pass *analysis.Pass, insp *inspector.Inspector) {
 			r.checkGoStmt(cctx, node)
 		case *ast.CallExpr:
 			r.checkCallExpr(cctx, node)
+		case *ast.FuncDecl, *ast.FuncLit:
+			// Only needed for the stack
+		default:
+			r.checkNode(cctx, node)
 		}
 
 		return true
@@ -111,7 +144,7 @@ func (r *Runner) checkGoStmt(cctx *probe.Context, stmt *ast.GoStmt) {
 		}
 
 		if msg != "" {
-			cctx.Pass.Reportf(stmt.Pos(), "%s", msg)
+			r.report(cctx, stmt.Pos(), checker.Name(), msg, result)
 		}
 	}
 }
@@ -132,13 +165,62 @@ func (r *Runner) checkCallExpr(cctx *probe.Context, call *ast.CallExpr) {
 			continue
 		}
 
+		for _, failure := range result.Failures() {
+			pos := getCallReportPos(call)
+			if failure.Pos.IsValid() {
+				if r.shouldIgnore(cctx.Pass, failure.Pos, checker.Name()) {
+					continue
+				}
+				pos = failure.Pos
+			}
+			r.report(cctx, pos, checker.Name(), failure.Message, failure)
+		}
+	}
+}
+
+// checkNode runs all Node checkers.
+func (r *Runner) checkNode(cctx *probe.Context, node ast.Node) {
+	for _, checker := range r.nodeCheckers {
+		if r.shouldIgnore(cctx.Pass, node.Pos(), checker.Name()) {
+			continue
+		}
+
+		result := checker.CheckNode(cctx, node)
+		if result.OK {
+			continue
+		}
+
 		if result.Message != "" {
-			reportPos := getCallReportPos(call)
-			cctx.Pass.Reportf(reportPos, "%s", result.Message)
+			r.report(cctx, node.Pos(), checker.Name(), result.Message, result)
 		}
 	}
 }
 
+// report emits a diagnostic categorized by the checker that produced it.
+// Failures below the -min-confidence threshold are dropped. The related
+// information ends with the declaration of the context in scope.
+func (r *Runner) report(cctx *probe.Context, pos token.Pos, checkerName ignore.CheckerName, msg string, result *Result) {
+	if !result.Confidence.AtLeast(r.opts.MinConfidence) {
+		return
+	}
+
+	related := result.Related
+	if cctx.CtxDeclPos.IsValid() {
+		related = append(slices.Clip(related), analysis.RelatedInformation{
+			Pos:     cctx.CtxDeclPos,
+			Message: "context declared here",
+		})
+	}
+
+	cctx.Pass.Report(analysis.Diagnostic{
+		Pos:            pos,
+		Category:       string(checkerName),
+		Message:        msg,
+		SuggestedFixes: result.Fixes,
+		Related:        related,
+	})
+}
+
 // getCallReportPos returns the best position to report for a call expression.
 func getCallReportPos(call *ast.CallExpr) token.Pos {
 	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
@@ -150,7 +232,10 @@ func getCallReportPos(call *ast.CallExpr) token.Pos {
 // shouldIgnore checks if the position should be ignored for the given checker.
 func (r *Runner) shouldIgnore(pass *analysis.Pass, pos token.Pos, checkerName ignore.CheckerName) bool {
 	filename := pass.Fset.Position(pos).Filename
-	ignoreMap, ok := r.ignoreMaps[filename]
+	if enabled, ok := r.opts.FileEnabled[filename]; ok && !enabled[checkerName] {
+		return true // Disabled by a directory config
+	}
+	ignoreMap, ok := r.opts.IgnoreMaps[filename]
 	if !ok {
 		return false
 	}
@@ -29,7 +29,7 @@
 //
 // Use [Build] to create a scope map for all functions in a package:
 //
-//	funcScopes := scope.Build(pass, inspector, carriers)
+//	funcScopes := scope.Build(pass, inspector, carriers, receiverFields, exportedOnly, localVars)
 //
 // The resulting [Map] maps AST nodes (FuncDecl, FuncLit) to their [Scope]:
 //
@@ -73,4 +73,35 @@
 //	        // inner has its own scope with ["innerCtx"]
 //	    }
 //	}
+//
+// # Receiver Fields
+//
+// With receiverFields (-slog-struct-ctx or -track-struct-ctx-fields), a method
+// without context parameters has scope if its receiver struct has context fields:
+//
+//	type server struct{ ctx context.Context }
+//
+//	func (s *server) handle() {
+//	    // ctx available: ["s.ctx"]
+//	}
+//
+// # HTTP Handlers
+//
+// Methods implementing http.Handler have scope through their request,
+// which is also added to the scope's carriers:
+//
+//	func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	    // ctx available: ["r.Context()"]
+//	}
+//
+// # Local Variables
+//
+// With localVars (-track-local-contexts), context variables declared inside
+// a function have scope too, from the point they become visible:
+//
+//	func run() {
+//	    // No context in scope
+//	    ctx := context.Background()
+//	    // ctx available: ["ctx"]
+//	}
 package scope
@@ -3,6 +3,9 @@ package scope
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/ast/inspector"
@@ -13,34 +16,184 @@ import (
 
 // Scope holds context information for a function scope.
 type Scope struct {
+	// CtxNames lists context and carrier parameter names in signature order.
+	// Messages name the first one, whichever kind it is.
 	CtxNames []string
+
+	// DeclPos is the position where the first context in CtxNames is
+	// declared, such as its parameter or struct field.
+	DeclPos token.Pos
+
+	// From is the position where a local context variable becomes visible.
+	// It is token.NoPos for function scopes.
+	From token.Pos
+
+	// Carriers lists carrier types implied by the function itself, such as
+	// *http.Request in ServeHTTP methods, on top of the configured ones.
+	Carriers []carrier.Carrier
+
+	// outer is the scope of an earlier local variable bound to the same node.
+	outer *Scope
 }
 
+// httpRequest is the carrier implied by ServeHTTP methods.
+var httpRequest = carrier.Carrier{PkgPath: "net/http", TypeName: "Request"}
+
 // Map maps AST nodes to their scopes.
 type Map map[ast.Node]*Scope
 
 // Build identifies functions with context parameters.
-func Build(pass *analysis.Pass, insp *inspector.Inspector, carriers []carrier.Carrier) Map {
+// If receiverFields is true, methods without context parameters whose
+// receiver struct has context.Context fields get a scope naming those
+// fields (e.g., "s.ctx").
+// If exportedOnly is true, only exported top-level functions and the func
+// literals nested in them get scopes, so everything else goes unchecked.
+// If localVars is true, context variables introduced by short variable
+// declarations and type switches get scopes as well; see buildLocalScopes.
+func Build(pass *analysis.Pass, insp *inspector.Inspector, carriers []carrier.Carrier, receiverFields, exportedOnly, localVars bool) Map {
 	m := make(Map)
 
+	var decl *ast.FuncDecl // Most recently visited FuncDecl
 	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}, func(n ast.Node) {
 		var fnType *ast.FuncType
 
 		switch fn := n.(type) {
 		case *ast.FuncDecl:
+			decl = fn
 			fnType = fn.Type
 		case *ast.FuncLit:
+			if decl != nil && (fn.Pos() < decl.Pos() || decl.End() < fn.End()) {
+				decl = nil // Package-level func literal
+			}
 			fnType = fn.Type
 		}
 
+		if exportedOnly && (decl == nil || !isExported(pass, decl)) {
+			return
+		}
+
 		if scope := findScope(pass, fnType, carriers); scope != nil {
 			m[n] = scope
+			return
+		}
+
+		decl, ok := n.(*ast.FuncDecl)
+		if !ok {
+			return
+		}
+		if scope := findHandlerScope(pass, decl); scope != nil {
+			m[n] = scope
+			return
+		}
+		if receiverFields {
+			if scope := findReceiverScope(pass, decl); scope != nil {
+				m[n] = scope
+			}
 		}
 	})
 
+	if localVars {
+		buildLocalScopes(pass, insp, carriers, exportedOnly, m)
+	}
+
 	return m
 }
 
+// buildLocalScopes adds scopes for context variables that are not parameters:
+//
+//	c, cancel := context.WithCancel(context.Background()) // visible after the statement
+//	switch c := v.(type) {
+//	case context.Context: // visible in this clause
+//	}
+//
+// A local scope is bound to the node holding the variable (the enclosing
+// statement list, or the case clause) and also names the contexts of the
+// scope it extends.
+func buildLocalScopes(pass *analysis.Pass, insp *inspector.Inspector, carriers []carrier.Carrier, exportedOnly bool, m Map) {
+	isCtx := func(obj types.Object) bool {
+		return obj != nil && obj.Name() != "_" &&
+			(typeutil.IsContextType(obj.Type()) || carrier.IsCarrierType(obj.Type(), carriers))
+	}
+
+	insp.WithStack([]ast.Node{(*ast.AssignStmt)(nil), (*ast.CaseClause)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		if exportedOnly && !inExportedDecl(pass, stack) {
+			return true
+		}
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE || len(stack) < 2 {
+				return true
+			}
+			var names []string
+			var declPos token.Pos
+			for _, lhs := range n.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && isCtx(pass.TypesInfo.Defs[ident]) {
+					if len(names) == 0 {
+						declPos = ident.Pos()
+					}
+					names = append(names, ident.Name)
+				}
+			}
+			addLocalScope(m, stack, stack[len(stack)-2], names, declPos, n.End())
+
+		case *ast.CaseClause:
+			if obj := pass.TypesInfo.Implicits[n]; isCtx(obj) {
+				addLocalScope(m, stack, n, []string{obj.Name()}, obj.Pos(), n.Colon)
+			}
+		}
+		return true
+	})
+}
+
+// addLocalScope binds a scope naming the local contexts to node, visible from pos.
+// declPos is the declaration of the first local context.
+func addLocalScope(m Map, stack []ast.Node, node ast.Node, names []string, declPos, pos token.Pos) {
+	if len(names) == 0 {
+		return
+	}
+
+	scope := &Scope{DeclPos: declPos, From: pos, outer: m[node]}
+	if enclosing := FindEnclosing(m, stack); enclosing != nil {
+		scope.CtxNames = append(scope.CtxNames, enclosing.CtxNames...)
+		scope.DeclPos = enclosing.DeclPos
+		scope.Carriers = enclosing.Carriers
+	}
+	for _, name := range names {
+		if !slices.Contains(scope.CtxNames, name) {
+			scope.CtxNames = append(scope.CtxNames, name)
+		}
+	}
+	m[node] = scope
+}
+
+// inExportedDecl checks if the stack is inside an exported top-level function.
+func inExportedDecl(pass *analysis.Pass, stack []ast.Node) bool {
+	for _, n := range stack {
+		if decl, ok := n.(*ast.FuncDecl); ok {
+			return isExported(pass, decl)
+		}
+	}
+	return false
+}
+
+// isExported checks if a top-level function is part of the package API.
+// Methods also need an exported receiver type.
+func isExported(pass *analysis.Pass, decl *ast.FuncDecl) bool {
+	if !decl.Name.IsExported() {
+		return false
+	}
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return true
+	}
+
+	named, ok := typeutil.UnwrapPointer(pass.TypesInfo.TypeOf(decl.Recv.List[0].Type)).(*types.Named)
+	return ok && named.Obj().Exported()
+}
+
 // findScope checks if the function has context parameters.
 func findScope(pass *analysis.Pass, fnType *ast.FuncType, carriers []carrier.Carrier) *Scope {
 	if fnType == nil || fnType.Params == nil {
@@ -48,6 +201,7 @@ func findScope(pass *analysis.Pass, fnType *ast.FuncType, carriers []carrier.Car
 	}
 
 	var ctxNames []string
+	var declPos token.Pos
 
 	for _, field := range fnType.Params.List {
 		typ := pass.TypesInfo.TypeOf(field.Type)
@@ -57,6 +211,9 @@ func findScope(pass *analysis.Pass, fnType *ast.FuncType, carriers []carrier.Car
 
 		if typeutil.IsContextType(typ) || carrier.IsCarrierType(typ, carriers) {
 			for _, name := range field.Names {
+				if len(ctxNames) == 0 {
+					declPos = name.Pos()
+				}
 				ctxNames = append(ctxNames, name.Name)
 			}
 		}
@@ -66,14 +223,94 @@ func findScope(pass *analysis.Pass, fnType *ast.FuncType, carriers []carrier.Car
 		return nil
 	}
 
-	return &Scope{CtxNames: ctxNames}
+	return &Scope{CtxNames: ctxNames, DeclPos: declPos}
+}
+
+// findHandlerScope checks if the method implements http.Handler:
+//
+//	func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request)
+//
+// The request then carries the context, available as r.Context().
+func findHandlerScope(pass *analysis.Pass, decl *ast.FuncDecl) *Scope {
+	if decl.Recv == nil || decl.Name.Name != "ServeHTTP" {
+		return nil
+	}
+
+	obj, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func)
+	if !ok {
+		return nil
+	}
+	params := obj.Signature().Params()
+	if params.Len() != 2 || obj.Signature().Results().Len() != 0 {
+		return nil
+	}
+	if _, ok := params.At(1).Type().(*types.Pointer); !ok || !httpRequest.Matches(params.At(1).Type()) {
+		return nil
+	}
+
+	name := params.At(1).Name()
+	if name == "" || name == "_" {
+		return nil
+	}
+
+	return &Scope{
+		CtxNames: []string{name + ".Context()"},
+		DeclPos:  params.At(1).Pos(),
+		Carriers: []carrier.Carrier{httpRequest},
+	}
 }
 
-// FindEnclosing finds the closest enclosing function with a context parameter.
+// findReceiverScope checks if the method receiver is a struct with context fields.
+func findReceiverScope(pass *analysis.Pass, decl *ast.FuncDecl) *Scope {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 || len(decl.Recv.List[0].Names) == 0 {
+		return nil
+	}
+
+	recv := decl.Recv.List[0].Names[0]
+	if recv.Name == "_" {
+		return nil
+	}
+
+	typ := pass.TypesInfo.TypeOf(decl.Recv.List[0].Type)
+	if typ == nil {
+		return nil
+	}
+	st, ok := typeutil.UnwrapPointer(typ).Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+
+	var ctxNames []string
+	var declPos token.Pos
+	for field := range st.Fields() {
+		if !field.Embedded() && typeutil.IsContextType(field.Type()) {
+			if len(ctxNames) == 0 {
+				declPos = field.Pos()
+			}
+			ctxNames = append(ctxNames, recv.Name+"."+field.Name())
+		}
+	}
+
+	if len(ctxNames) == 0 {
+		return nil
+	}
+
+	return &Scope{CtxNames: ctxNames, DeclPos: declPos}
+}
+
+// FindEnclosing finds the closest enclosing scope of the last node in stack.
+// Local scopes only count once their variable is visible.
 func FindEnclosing(scopes Map, stack []ast.Node) *Scope {
+	if len(stack) == 0 {
+		return nil
+	}
+	pos := stack[len(stack)-1].Pos()
+
 	for i := len(stack) - 1; i >= 0; i-- {
-		if scope, ok := scopes[stack[i]]; ok {
-			return scope
+		for scope := scopes[stack[i]]; scope != nil; scope = scope.outer {
+			if scope.From <= pos {
+				return scope
+			}
 		}
 	}
 
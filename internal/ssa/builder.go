@@ -89,3 +89,57 @@ func (p *Program) FindFuncDecl(decl *ast.FuncDecl) *ssa.Function {
 	}
 	return nil
 }
+
+// CallAt finds the SSA call instruction for a given CallExpr AST node.
+// Calls inside nested func literals are also found.
+func (p *Program) CallAt(call *ast.CallExpr) *ssa.Call {
+	if p == nil || call == nil {
+		return nil
+	}
+
+	topFn := p.FuncAt(call)
+	if topFn == nil {
+		return nil
+	}
+
+	return p.findCallInFunc(topFn, call)
+}
+
+// ArgAt returns the SSA value passed as the idx-th argument of call.
+// Receivers of static method calls are skipped, so idx matches the AST.
+// Returns nil if the call or the argument can't be located, including
+// arguments packed into a variadic slice.
+func (p *Program) ArgAt(call *ast.CallExpr, idx int) ssa.Value {
+	ssaCall := p.CallAt(call)
+	if ssaCall == nil {
+		return nil
+	}
+
+	sig := ssaCall.Call.Signature()
+	if idx < 0 || idx >= sig.Params().Len() || (sig.Variadic() && idx == sig.Params().Len()-1) {
+		return nil
+	}
+
+	args := ssaCall.Call.Args
+	offset := len(args) - sig.Params().Len()
+	if offset < 0 {
+		return nil
+	}
+	return args[offset+idx]
+}
+
+func (p *Program) findCallInFunc(fn *ssa.Function, call *ast.CallExpr) *ssa.Call {
+	for _, block := range fn.Blocks {
+		for _, instr := range block.Instrs {
+			if c, ok := instr.(*ssa.Call); ok && c.Pos() == call.Lparen {
+				return c
+			}
+		}
+	}
+	for _, anon := range fn.AnonFuncs {
+		if found := p.findCallInFunc(anon, call); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package ssa
+
+import (
+	"go/types"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// CanceledBefore checks if v is a context returned by a cancelable
+// constructor (context.WithCancel, WithTimeout, WithDeadline and their
+// Cause variants) whose cancel function is definitely called before instr.
+// Deferred calls and cancel functions that escape are not considered.
+func (t *Tracer) CanceledBefore(v ssa.Value, instr ssa.Instruction) bool {
+	cancel := cancelFuncOf(v)
+	if cancel == nil {
+		return false
+	}
+
+	refs := cancel.Referrers()
+	if refs == nil {
+		return false
+	}
+
+	for _, ref := range *refs {
+		call, ok := ref.(*ssa.Call)
+		if !ok || call.Call.Value != cancel {
+			continue
+		}
+		if precedes(call, instr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cancelFuncOf returns the cancel function extracted from the same
+// constructor call as the context v, or nil if v is not such a context.
+func cancelFuncOf(v ssa.Value) ssa.Value {
+	extract, ok := v.(*ssa.Extract)
+	if !ok || extract.Index != 0 {
+		return nil
+	}
+	call, ok := extract.Tuple.(*ssa.Call)
+	if !ok || !isCancelableConstructor(&call.Call) {
+		return nil
+	}
+
+	refs := call.Referrers()
+	if refs == nil {
+		return nil
+	}
+	for _, ref := range *refs {
+		if e, ok := ref.(*ssa.Extract); ok && e.Index == 1 {
+			return e
+		}
+	}
+	return nil
+}
+
+// isCancelableConstructor checks if the call is a context package function
+// returning (context.Context, context.CancelFunc) or a CancelCauseFunc.
+func isCancelableConstructor(call *ssa.CallCommon) bool {
+	fn := ExtractCalledFunc(call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "context" {
+		return false
+	}
+
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Results().Len() != 2 {
+		return false
+	}
+
+	named, ok := sig.Results().At(1).Type().(*types.Named)
+	if !ok {
+		return false
+	}
+	name := named.Obj().Name()
+	return name == "CancelFunc" || name == "CancelCauseFunc"
+}
+
+// precedes checks if a is executed before b on every path reaching b.
+// Both must belong to the same function; a dominates b if it comes
+// earlier in the same block or its block dominates b's block.
+func precedes(a, b ssa.Instruction) bool {
+	if a.Parent() != b.Parent() {
+		return false
+	}
+
+	ab, bb := a.Block(), b.Block()
+	if ab != bb {
+		return ab.Dominates(bb)
+	}
+
+	for _, instr := range ab.Instrs {
+		switch instr {
+		case a:
+			return true
+		case b:
+			return false
+		}
+	}
+	return false
+}
@@ -73,6 +73,14 @@
 //	    }
 //	}
 //
+// A captured channel of contexts also counts when the closure receives from it,
+// since the received value is a context flowing into the goroutine:
+//
+//	ch := make(chan context.Context)
+//	go func() {
+//	    doWork(<-ch)  // ch is a FreeVar of type chan context.Context
+//	}()
+//
 // # Deriver Detection
 //
 // For goroutine-derive checking, the tracer:
@@ -88,6 +96,37 @@
 //	    doWork(ctx)
 //	}()
 //
+// # Cancel Ordering
+//
+// [Tracer.CanceledBefore] checks whether a derived context's cancel function
+// is called on every path before a given instruction, using block dominance:
+//
+//	ctx2, cancel := context.WithCancel(ctx)
+//	cancel()
+//	doWork(ctx2)  // cancel() dominates this call
+//
+// # Merged Func Values
+//
+// [Tracer.PhiFuncSources] resolves a func variable assigned on several
+// branches to every function it may hold, following the edges of its Phi
+// node. [Program.ArgAt] locates the SSA value passed to a call:
+//
+//	var fn func() error
+//	if fast {
+//	    fn = quick
+//	} else {
+//	    fn = slow  // fn at g.Go is Phi [quick, slow]
+//	}
+//	g.Go(fn)
+//
+// # Wrapper Tracing
+//
+// [Tracer.BuiltWithout] follows a value back to its construction by a
+// package and checks that it passed through a given wrapper on the way:
+//
+//	b := backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3)
+//	backoff.Retry(op, b)  // built without backoff.WithContext
+//
 // # Helper Functions
 //
 // The package exports helper functions for SSA analysis:
@@ -1,7 +1,9 @@
 package ssa
 
 import (
+	"go/token"
 	"go/types"
+	"slices"
 
 	"golang.org/x/tools/go/ssa"
 
@@ -30,8 +32,175 @@ func (t *Tracer) ClosureCapturesContext(closure *ssa.Function, carriers []carrie
 		if typeutil.IsContextType(fv.Type()) || carrier.IsCarrierType(fv.Type(), carriers) {
 			return true
 		}
+		if isContextChan(fv.Type(), carriers) && receivesFrom(closure, fv) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isContextChan checks if the type is a channel of context.Context or a carrier.
+// Captured channels may appear behind a pointer when the variable is reassigned.
+func isContextChan(t types.Type, carriers []carrier.Carrier) bool {
+	ch, ok := typeutil.UnwrapPointer(t).Underlying().(*types.Chan)
+	if !ok || ch.Dir() == types.SendOnly {
+		return false
 	}
+	return typeutil.IsContextType(ch.Elem()) || carrier.IsCarrierType(ch.Elem(), carriers)
+}
+
+// receivesFrom checks if the closure receives from the captured channel,
+// either directly (<-ch, range ch) or as a select case.
+func receivesFrom(closure *ssa.Function, fv *ssa.FreeVar) bool {
+	for _, block := range closure.Blocks {
+		for _, instr := range block.Instrs {
+			switch v := instr.(type) {
+			case *ssa.UnOp:
+				if v.Op == token.ARROW && refersTo(v.X, fv) {
+					return true
+				}
+			case *ssa.Select:
+				for _, state := range v.States {
+					if state.Dir == types.RecvOnly && refersTo(state.Chan, fv) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
 
+// refersTo checks if v is the free variable itself or a load from it.
+func refersTo(v ssa.Value, fv *ssa.FreeVar) bool {
+	if v == fv {
+		return true
+	}
+	if load, ok := v.(*ssa.UnOp); ok && load.Op == token.MUL {
+		return load.X == fv
+	}
+	return false
+}
+
+// ClosureReceivesStructContext checks if a closure receives a struct from a
+// captured channel and reads a context field of the received value:
+//
+//	go func() {
+//	    r := <-requests
+//	    handle(r.ctx)
+//	}()
+func (t *Tracer) ClosureReceivesStructContext(closure *ssa.Function) bool {
+	if closure == nil {
+		return false
+	}
+
+	for _, fv := range closure.FreeVars {
+		if !isStructContextChan(fv.Type()) {
+			continue
+		}
+		received := receivedValues(closure, fv)
+		if len(received) > 0 && readsContextField(closure, received) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStructContextChan checks if the type is a channel of structs, or
+// pointers to structs, with a context.Context field.
+func isStructContextChan(t types.Type) bool {
+	ch, ok := typeutil.UnwrapPointer(t).Underlying().(*types.Chan)
+	if !ok || ch.Dir() == types.SendOnly {
+		return false
+	}
+	st, ok := typeutil.UnwrapPointer(ch.Elem()).Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for field := range st.Fields() {
+		if typeutil.IsContextType(field.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// receivedValues collects the values the closure receives from the captured
+// channel, either directly (<-ch, range ch) or as a select case.
+func receivedValues(closure *ssa.Function, fv *ssa.FreeVar) map[ssa.Value]bool {
+	// Receive instructions whose result is a tuple -> index of the value
+	tuples := make(map[ssa.Value]int)
+	received := make(map[ssa.Value]bool)
+
+	for _, block := range closure.Blocks {
+		for _, instr := range block.Instrs {
+			switch v := instr.(type) {
+			case *ssa.UnOp:
+				if v.Op != token.ARROW || !refersTo(v.X, fv) {
+					continue
+				}
+				if v.CommaOk {
+					tuples[v] = 0
+				} else {
+					received[v] = true
+				}
+			case *ssa.Select:
+				// Select results are (index, recvOk, r_0, ..., r_n-1) for receive states only
+				recvIdx := 0
+				for _, state := range v.States {
+					if state.Dir != types.RecvOnly {
+						continue
+					}
+					if refersTo(state.Chan, fv) {
+						tuples[v] = 2 + recvIdx
+					}
+					recvIdx++
+				}
+			}
+		}
+	}
+
+	for _, block := range closure.Blocks {
+		for _, instr := range block.Instrs {
+			if ext, ok := instr.(*ssa.Extract); ok {
+				if idx, ok := tuples[ext.Tuple]; ok && ext.Index == idx {
+					received[ext] = true
+				}
+			}
+		}
+	}
+
+	// Struct variables whose fields are addressed stay in local allocations
+	for _, block := range closure.Blocks {
+		for _, instr := range block.Instrs {
+			if store, ok := instr.(*ssa.Store); ok && received[store.Val] {
+				if alloc, ok := store.Addr.(*ssa.Alloc); ok {
+					received[alloc] = true
+				}
+			}
+		}
+	}
+	return received
+}
+
+// readsContextField checks if the closure reads a context field of any of
+// the received values.
+func readsContextField(closure *ssa.Function, received map[ssa.Value]bool) bool {
+	for _, block := range closure.Blocks {
+		for _, instr := range block.Instrs {
+			switch v := instr.(type) {
+			case *ssa.Field:
+				if received[v.X] && typeutil.IsContextType(v.Type()) {
+					return true
+				}
+			case *ssa.FieldAddr:
+				if received[v.X] && typeutil.IsContextType(v.Type()) {
+					return true
+				}
+			}
+		}
+	}
 	return false
 }
 
@@ -39,6 +208,12 @@ func (t *Tracer) ClosureCapturesContext(closure *ssa.Function, carriers []carrie
 type DeriverResult struct {
 	FoundAtStart     bool
 	FoundOnlyInDefer bool
+
+	// Missing and Present describe the partially satisfied AND group with
+	// the fewest missing specs, if no group is satisfied at start.
+	// Both are empty when no group has any of its specs called.
+	Missing []funcspec.Spec
+	Present []funcspec.Spec
 }
 
 // ClosureCallsDeriver checks if a closure calls any of the required deriver functions.
@@ -49,26 +224,89 @@ func (t *Tracer) ClosureCallsDeriver(closure *ssa.Function, matcher *deriver.Mat
 
 	calls := t.collectDeriverCalls(closure, false, make(map[*ssa.Function]bool))
 
-	// Check if any OR group is satisfied at start
+	// Check if any OR group is satisfied at start, remembering the closest partial group
+	var result DeriverResult
 	for _, andGroup := range matcher.OrGroups {
-		if t.checkAndGroup(calls, andGroup, false) {
+		missing := t.checkAndGroup(calls, andGroup, false)
+		if len(missing) == 0 {
 			return DeriverResult{FoundAtStart: true}
 		}
+		if len(missing) < len(andGroup) && (result.Missing == nil || len(missing) < len(result.Missing)) {
+			result.Missing = missing
+			result.Present = presentSpecs(andGroup, missing)
+		}
 	}
 
 	// Check if deriver is only in defer
 	for _, andGroup := range matcher.OrGroups {
-		if t.checkAndGroup(calls, andGroup, true) {
-			return DeriverResult{FoundOnlyInDefer: true}
+		if len(t.checkAndGroup(calls, andGroup, true)) == 0 {
+			result.FoundOnlyInDefer = true
+			return result
 		}
 	}
 
-	return DeriverResult{}
+	return result
+}
+
+// presentSpecs returns the specs of andGroup that are not in missing.
+func presentSpecs(andGroup, missing []funcspec.Spec) []funcspec.Spec {
+	var present []funcspec.Spec
+	for _, spec := range andGroup {
+		if !slices.Contains(missing, spec) {
+			present = append(present, spec)
+		}
+	}
+	return present
+}
+
+// ClosureDerivesTwice checks if a closure calls the same deriver function
+// again after a previous call on every path, such as:
+//
+//	ctx := apm.NewGoroutineContext(ctx)
+//	ctx = apm.NewGoroutineContext(ctx)
+//
+// Calls in exclusive branches and deferred calls are not counted.
+func (t *Tracer) ClosureDerivesTwice(closure *ssa.Function, matcher *deriver.Matcher) bool {
+	if closure == nil || matcher == nil || matcher.IsEmpty() {
+		return false
+	}
+
+	calls := t.collectDeriverCalls(closure, false, make(map[*ssa.Function]bool))
+
+	var derives []deriverCall
+	for _, call := range calls {
+		if !call.inDefer && call.instr != nil && matchesAnySpec(matcher, call.fn) {
+			derives = append(derives, call)
+		}
+	}
+
+	for i, a := range derives {
+		for _, b := range derives[i+1:] {
+			if a.fn == b.fn && (precedes(a.instr, b.instr) || precedes(b.instr, a.instr)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesAnySpec checks if fn matches any spec of the matcher.
+func matchesAnySpec(matcher *deriver.Matcher, fn *types.Func) bool {
+	for _, andGroup := range matcher.OrGroups {
+		for _, spec := range andGroup {
+			if spec.Matches(fn) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 type deriverCall struct {
 	fn      *types.Func
 	inDefer bool
+	instr   ssa.Instruction // Call site; nil for defer statements
 }
 
 func (t *Tracer) collectDeriverCalls(fn *ssa.Function, inDefer bool, visited map[*ssa.Function]bool) []deriverCall {
@@ -84,7 +322,7 @@ func (t *Tracer) collectDeriverCalls(fn *ssa.Function, inDefer bool, visited map
 			switch v := instr.(type) {
 			case *ssa.Call:
 				if calledFn := ExtractCalledFunc(&v.Call); calledFn != nil {
-					calls = append(calls, deriverCall{fn: calledFn, inDefer: inDefer})
+					calls = append(calls, deriverCall{fn: calledFn, inDefer: inDefer, instr: v})
 				}
 				if iifeFn := ExtractIIFE(&v.Call); iifeFn != nil {
 					calls = append(calls, t.collectDeriverCalls(iifeFn, inDefer, visited)...)
@@ -104,7 +342,10 @@ func (t *Tracer) collectDeriverCalls(fn *ssa.Function, inDefer bool, visited map
 	return calls
 }
 
-func (t *Tracer) checkAndGroup(calls []deriverCall, andGroup []funcspec.Spec, includeDefer bool) bool {
+// checkAndGroup returns the specs of the AND group that are never called.
+// The group is satisfied if the result is empty.
+func (t *Tracer) checkAndGroup(calls []deriverCall, andGroup []funcspec.Spec, includeDefer bool) []funcspec.Spec {
+	var missing []funcspec.Spec
 	for _, spec := range andGroup {
 		found := false
 		for _, call := range calls {
@@ -117,10 +358,63 @@ func (t *Tracer) checkAndGroup(calls []deriverCall, andGroup []funcspec.Spec, in
 			}
 		}
 		if !found {
+			missing = append(missing, spec)
+		}
+	}
+	return missing
+}
+
+// PhiFuncSources resolves a func value merged by a Phi node, as in
+// "var fn func(); if cond { fn = a } else { fn = b }", to the functions
+// reaching it along every edge: closures and plain function references.
+// Nil edges are skipped. Returns false if v is not a Phi or any edge
+// holds something else, such as a parameter or a call result.
+func (t *Tracer) PhiFuncSources(v ssa.Value) ([]*ssa.Function, bool) {
+	phi, ok := v.(*ssa.Phi)
+	if !ok {
+		return nil, false
+	}
+
+	var sources []*ssa.Function
+	if !collectFuncSources(phi, make(map[*ssa.Phi]bool), &sources) {
+		return nil, false
+	}
+	return sources, true
+}
+
+// collectFuncSources appends the functions held by v to sources,
+// following nested Phi nodes once each.
+func collectFuncSources(v ssa.Value, visited map[*ssa.Phi]bool, sources *[]*ssa.Function) bool {
+	switch v := v.(type) {
+	case *ssa.Phi:
+		if visited[v] {
+			return true
+		}
+		visited[v] = true
+		for _, edge := range v.Edges {
+			if !collectFuncSources(edge, visited, sources) {
+				return false
+			}
+		}
+		return true
+
+	case *ssa.MakeClosure:
+		fn, ok := v.Fn.(*ssa.Function)
+		if !ok {
 			return false
 		}
+		*sources = append(*sources, fn)
+		return true
+
+	case *ssa.Function:
+		*sources = append(*sources, v)
+		return true
+
+	case *ssa.Const:
+		return v.IsNil()
 	}
-	return true
+
+	return false
 }
 
 // =============================================================================
@@ -188,3 +482,42 @@ func HasFuncArgs(call *ssa.CallCommon, startIdx int) bool {
 	}
 	return false
 }
+
+// ResultUnused checks if the call's result at idx is never used.
+// A result discarded with "_" or only assigned with "_ = v" has no referrers.
+func (t *Tracer) ResultUnused(call *ssa.Call, idx int) bool {
+	refs := call.Referrers()
+	if refs == nil {
+		return true
+	}
+
+	// Single-value calls are used directly.
+	if _, isTuple := call.Type().(*types.Tuple); !isTuple {
+		return !hasRealReferrers(call)
+	}
+
+	for _, ref := range *refs {
+		extract, ok := ref.(*ssa.Extract)
+		if !ok || extract.Index != idx {
+			continue
+		}
+		if hasRealReferrers(extract) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasRealReferrers checks if a value has referrers other than debug info.
+func hasRealReferrers(v ssa.Value) bool {
+	refs := v.Referrers()
+	if refs == nil {
+		return false
+	}
+	for _, ref := range *refs {
+		if _, ok := ref.(*ssa.DebugRef); !ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package ssa
+
+import (
+	"go/types"
+
+	"golang.org/x/tools/go/ssa"
+
+	"github.com/mpyw/goroutinectx/internal/funcspec"
+	"github.com/mpyw/goroutinectx/internal/typeutil"
+)
+
+// BuiltWithout checks if v is built by the package of wrapper without ever
+// passing through wrapper itself, as in:
+//
+//	b := backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3)
+//	backoff.Retry(op, b) // b never went through backoff.WithContext
+//
+// Interface conversions and Phi edges are followed, as are calls of the
+// package taking the value to wrap as an interface first argument.
+// Values of unknown origin, such as parameters or results of other
+// packages, are assumed to be wrapped.
+func (t *Tracer) BuiltWithout(v ssa.Value, wrapper funcspec.Spec) bool {
+	return builtWithout(v, wrapper, make(map[*ssa.Phi]bool))
+}
+
+// builtWithout follows v to its construction, visiting each Phi once.
+func builtWithout(v ssa.Value, wrapper funcspec.Spec, visited map[*ssa.Phi]bool) bool {
+	switch v := v.(type) {
+	case *ssa.MakeInterface:
+		return builtWithout(v.X, wrapper, visited)
+
+	case *ssa.ChangeInterface:
+		return builtWithout(v.X, wrapper, visited)
+
+	case *ssa.ChangeType:
+		return builtWithout(v.X, wrapper, visited)
+
+	case *ssa.Phi:
+		if visited[v] {
+			return false
+		}
+		visited[v] = true
+		for _, edge := range v.Edges {
+			if builtWithout(edge, wrapper, visited) {
+				return true
+			}
+		}
+		return false
+
+	case *ssa.Call:
+		fn := ExtractCalledFunc(&v.Call)
+		if fn == nil || fn.Pkg() == nil || !wrapper.MatchesPkg(fn.Pkg().Path()) || wrapper.Matches(fn) {
+			return false
+		}
+		if args := v.Call.Args; len(args) > 0 && types.IsInterface(args[0].Type()) {
+			return builtWithout(args[0], wrapper, visited)
+		}
+		return true
+
+	case *ssa.Alloc:
+		named, ok := typeutil.UnwrapPointer(v.Type()).(*types.Named)
+		return ok && named.Obj().Pkg() != nil && wrapper.MatchesPkg(named.Obj().Pkg().Path())
+	}
+
+	return false
+}
@@ -6,15 +6,26 @@ import (
 
 const contextPkgPath = "context"
 
-// IsContextType checks if the type is context.Context.
+// IsContextType checks if the type is context.Context, or a type parameter
+// whose constraint is or embeds it:
+//
+//	func Make[C context.Context](c C) func() { ... }
 func IsContextType(t types.Type) bool {
 	t = UnwrapPointer(t)
 
+	if tp, ok := t.(*types.TypeParam); ok {
+		return ConstraintMatches(tp, isContextNamed)
+	}
+
 	named, ok := t.(*types.Named)
 	if !ok {
 		return false
 	}
+	return isContextNamed(named)
+}
 
+// isContextNamed checks if the named type is context.Context.
+func isContextNamed(named *types.Named) bool {
 	obj := named.Obj()
 	if obj == nil || obj.Pkg() == nil {
 		return false
@@ -46,3 +57,40 @@ func UnwrapPointer(t types.Type) types.Type {
 		t = ptr.Elem()
 	}
 }
+
+// EmbedsNamed checks if t is an interface embedding a named type that
+// satisfies match, directly or through other embedded interfaces:
+//
+//	type Handler interface {
+//	    echo.Context
+//	}
+func EmbedsNamed(t types.Type, match func(*types.Named) bool) bool {
+	iface, ok := UnwrapPointer(t).Underlying().(*types.Interface)
+	if !ok {
+		return false
+	}
+
+	for i := range iface.NumEmbeddeds() {
+		embedded := iface.EmbeddedType(i)
+		if named, ok := embedded.(*types.Named); ok && match(named) {
+			return true
+		}
+		if EmbedsNamed(embedded, match) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConstraintMatches checks if the constraint of the type parameter is a
+// named type that satisfies match, or an interface embedding one:
+//
+//	func Make[C context.Context](c C)
+//	func Make[C interface{ context.Context }](c C)
+func ConstraintMatches(tp *types.TypeParam, match func(*types.Named) bool) bool {
+	constraint := tp.Constraint()
+	if named, ok := constraint.(*types.Named); ok && match(named) {
+		return true
+	}
+	return EmbedsNamed(constraint, match)
+}
@@ -0,0 +1,81 @@
+package goroutinectx
+
+import (
+	"github.com/mpyw/goroutinectx/internal/directive/ignore"
+)
+
+// SeverityWarning is the severity of every built-in rule.
+// go/analysis has no notion of severity; it is exposed for editors and
+// other tools consuming Rules.
+const SeverityWarning = "warning"
+
+// Rule describes a diagnostic category reported by the analyzer.
+type Rule struct {
+	Category     string `json:"category"`     // Diagnostic category, also accepted by ignore directives
+	Severity     string `json:"severity"`     // Default severity
+	Description  string `json:"description"`  // Short description
+	SuggestedFix bool   `json:"suggestedFix"` // Whether diagnostics may carry a suggested fix
+	Default      bool   `json:"default"`      // Whether the rule is enabled without flags
+}
+
+// rules lists every built-in rule in checker registration order.
+var rules = []Rule{
+	{Category: string(ignore.Goroutine), Description: "go statements should propagate the context in scope", Default: true},
+	{Category: string(ignore.GoroutineDerive), Description: "goroutines should call the configured deriver function (-goroutine-deriver)"},
+	{Category: string(ignore.Waitgroup), Description: "sync.WaitGroup.Go closures should propagate the context in scope", Default: true},
+	{Category: string(ignore.Errgroup), Description: "errgroup and conc closures should propagate the context in scope", Default: true},
+	{Category: string(ignore.Spawner), Description: "func arguments of spawner functions should propagate the context in scope", Default: true},
+	{Category: string(ignore.Spawnerlabel), Description: "functions spawning goroutines with func arguments should be marked //goroutinectx:spawner"},
+	{Category: string(ignore.Gotask), Description: "gotask tasks should call the configured deriver function (-goroutine-deriver)", Default: true},
+	{Category: string(ignore.Signal), Description: "use signal.NotifyContext instead of signal.Notify", SuggestedFix: true},
+	{Category: string(ignore.NilCtx), Description: "context.Context should not be assigned or compared to nil"},
+	{Category: string(ignore.GroupCtx), Description: "the context returned by errgroup.WithContext should be used"},
+	{Category: string(ignore.TimeTick), Description: "use time.NewTicker with ctx.Done() instead of time.Tick"},
+	{Category: string(ignore.WithoutCancel), Description: "context.WithoutCancel inside goroutines detaches them from cancellation"},
+	{Category: string(ignore.ReturnCtxErr), Description: "errgroup closures should return ctx.Err() from ctx.Done() cases in unbounded loops"},
+	{Category: string(ignore.BlockingIO), Description: "blocking I/O inside goroutines should be cancellable through the context"},
+	{Category: string(ignore.CtxInSlice), Description: "contexts should not be stored in slices or maps inside goroutines"},
+	{Category: string(ignore.CtxChanSend), Description: "contexts should not be sent on channels from inside goroutines"},
+	{Category: string(ignore.UseAfterCancel), Description: "derived contexts should not be used after their cancel function is called"},
+	{Category: string(ignore.CtxRequired), Description: "functions listed in -ctx-required-funcs should be called with a context in scope"},
+	{Category: string(ignore.ExecCommand), Description: "use exec.CommandContext instead of exec.Command", SuggestedFix: true},
+	{Category: string(ignore.SlogCtx), Description: "use the ...Context variants of slog functions", SuggestedFix: true},
+	{Category: string(ignore.RedundantDerive), Description: "goroutines should call the deriver function only once"},
+	{Category: string(ignore.WaitError), Description: "errgroup.Wait() errors should not be discarded"},
+	{Category: string(ignore.CheckArg), Description: "arguments marked with //goroutinectx:check-arg should use the context in scope", Default: true},
+	{Category: string(ignore.RequestCtx), Description: "HTTP middleware should not replace the request context with context.Background()"},
+	{Category: string(ignore.StaleCtx), Description: "goroutines should not use a receiver context field instead of the context in scope"},
+	{Category: string(ignore.PrintCtx), Description: "context.Context should not be formatted with %v by fmt print functions"},
+	{Category: string(ignore.IgnoredCtxErr), Description: "ctx.Err() results should not be discarded"},
+	{Category: string(ignore.LoopBackground), Description: "loops should share the context in scope instead of recreating context.Background()"},
+	{Category: string(ignore.CtxInDTO), Description: "context.Context should not be a field of JSON-serialized structs"},
+	{Category: string(ignore.CtxValueAssert), Description: "type assertions on ctx.Value results should use comma-ok"},
+	{Category: string(ignore.HandlerMap), Description: "dispatch map handlers should accept context.Context"},
+	{Category: string(ignore.CtxParamField), Description: "methods should not ignore their context parameter in favor of a receiver context field"},
+	{Category: string(ignore.CtxInCache), Description: "context.Context should not be stored in long-lived caches (-cache-set-funcs)"},
+	{Category: string(ignore.InitGoroutine), Description: "goroutines should not be spawned during package initialization"},
+	{Category: string(ignore.EscapingGoroutine), Description: "closures run by errgroup, sync.WaitGroup or conc should not start bare goroutines"},
+	{Category: string(ignore.Backoff), Description: "backoff.Retry policies should be wrapped with backoff.WithContext"},
+	{Category: string(ignore.SyncOnce), Description: "sync.Once.Do callbacks should use the context in scope"},
+	{Category: string(ignore.CtxMapKey), Description: "context.Context should not be used as a map key"},
+	{Category: string(ignore.CtxInConstructor), Description: "constructors should not store their context parameter on the returned struct"},
+	{Category: string(ignore.StdLog), Description: "goroutines should log through a context-aware logger instead of stdlib log or fmt"},
+	{Category: string(ignore.UnguardedChanOp), Description: "channel operations inside goroutines should be in a select with ctx.Done()"},
+	{Category: string(ignore.CtxFreeVarStore), Description: "goroutines should not reassign context variables captured from the spawning function"},
+	{Category: string(ignore.Transitive), Description: "helpers that spawn goroutines or log should accept the context of their callers"},
+	{Category: string(ignore.LockedGoroutine), Description: "goroutines should not be awaited while the lock held when spawning them is still held"},
+	{Category: string(ignore.ClosureStore), Description: "closures capturing a request context should not be passed to functions storing them long-term (-closure-store-funcs)"},
+	{Category: configCategory, Description: "configured derivers and carriers should name a function or type declared in their package", Default: true},
+	{Category: unusedIgnoreCategory, Description: "//goroutinectx:ignore directives should suppress a diagnostic", Default: true},
+}
+
+// Rules returns metadata for every built-in rule.
+// Custom checkers registered with Register or RegisterGoStmt are not included.
+func Rules() []Rule {
+	result := make([]Rule, len(rules))
+	for i, r := range rules {
+		r.Severity = SeverityWarning
+		result[i] = r
+	}
+	return result
+}
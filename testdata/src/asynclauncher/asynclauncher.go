@@ -0,0 +1,99 @@
+// Package asynclauncher contains test fixtures for DoAsync-style APIs
+// configured through -async-launchers.
+package asynclauncher
+
+import (
+	"context"
+
+	"github.com/example/asyncjob"
+	"github.com/my-example-app/telemetry/apm"
+)
+
+//vt:helper
+func work(ctx context.Context) error {
+	return nil
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Builder context without deriver
+//
+// The context set by the builder method is not a deriver call.
+func badBuilderWithoutDeriver(ctx context.Context) {
+	asyncjob.New(work).WithContext(ctx).Start() // want `asyncjob.\(\*Job\).Start\(\) context set by WithContext should call goroutine deriver`
+}
+
+// [BAD]: Builder method missing
+//
+// A chain traced to its constructor without the builder method runs without the derived context.
+func badBuilderMissing(ctx context.Context) {
+	asyncjob.New(work).Retry(3).Start() // want `asyncjob.\(\*Job\).Start\(\) context set by WithContext should call goroutine deriver`
+}
+
+// [BAD]: Builder through a variable
+//
+// The receiver chain is traced through variable assignments.
+func badBuilderThroughVariable(ctx context.Context) {
+	job := asyncjob.New(work).WithContext(ctx)
+	job.Retry(3).Start() // want `asyncjob.\(\*Job\).Start\(\) context set by WithContext should call goroutine deriver`
+}
+
+// [BAD]: Positional context without deriver
+//
+// The context argument at the configured index is not a deriver call.
+func badPositionalWithoutDeriver(ctx context.Context) {
+	asyncjob.New(work).Run("sync", ctx) // want `asyncjob.\(\*Job\).Run\(\) 2nd argument should call goroutine deriver`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Builder context with deriver
+//
+// The builder method receives a deriver call.
+func goodBuilderWithDeriver(ctx context.Context) {
+	asyncjob.New(work).WithContext(apm.NewGoroutineContext(ctx)).Start()
+}
+
+// [GOOD]: Builder before other methods
+//
+// The builder method may come anywhere in the chain.
+func goodBuilderBeforeOtherMethods(ctx context.Context) {
+	asyncjob.New(work).WithContext(apm.NewGoroutineContext(ctx)).Retry(3).Start()
+}
+
+// [GOOD]: Builder through a variable
+//
+// A derived context set on a job stored in a variable is traced.
+func goodBuilderThroughVariable(ctx context.Context) {
+	job := asyncjob.New(work).WithContext(apm.NewGoroutineContext(ctx))
+	job.Start()
+}
+
+// [GOOD]: Derived context variable
+//
+// A variable holding a deriver call counts as the deriver call.
+func goodDerivedContextVariable(ctx context.Context) {
+	derived := apm.NewGoroutineContext(ctx)
+	asyncjob.New(work).WithContext(derived).Start()
+}
+
+// [GOOD]: Positional context with deriver
+//
+// The context argument at the configured index is a deriver call.
+func goodPositionalWithDeriver(ctx context.Context) {
+	asyncjob.New(work).Run("sync", apm.NewGoroutineContext(ctx))
+}
+
+// [GOOD]: Job from a parameter
+//
+// A chain that can't be traced is not reported.
+func goodJobFromParameter(ctx context.Context, job *asyncjob.Job) {
+	job.Start()
+}
+
+// [GOOD]: Non-launcher method
+//
+// Methods not configured as launchers are not checked.
+func goodNonLauncherMethod(ctx context.Context) {
+	_ = asyncjob.New(work).WithContext(ctx).Retry(3)
+}
@@ -0,0 +1,98 @@
+// Package backoff tests the backoff checker.
+package backoff
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+//vt:helper
+func fetch() error { return nil }
+
+//vt:helper
+func logRetry(err error, d time.Duration) {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Retry with a policy built without WithContext
+//
+// Retries keep going after ctx is canceled.
+func badRetryWithoutContext(ctx context.Context) error {
+	b := backoff.NewExponentialBackOff()
+	return backoff.Retry(fetch, b) // want `use backoff.WithContext so retries observe cancellation`
+}
+
+// [BAD]: RetryNotify with an inline policy
+//
+// RetryNotify takes the policy in the same position.
+func badRetryNotifyInline(ctx context.Context) error {
+	return backoff.RetryNotify(fetch, backoff.NewConstantBackOff(time.Second), logRetry) // want `use backoff.WithContext so retries observe cancellation`
+}
+
+// [BAD]: Retry with a policy wrapped only by WithMaxRetries
+//
+// Wrappers other than WithContext do not observe ctx.
+func badRetryMaxRetriesOnly(ctx context.Context) error {
+	b := backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3)
+	return backoff.Retry(fetch, b) // want `use backoff.WithContext so retries observe cancellation`
+}
+
+// [BAD]: Retry with a composite literal policy
+//
+// Policies built from struct literals are traced too.
+func badRetryCompositeLiteral(ctx context.Context) error {
+	return backoff.Retry(fetch, &backoff.ExponentialBackOff{InitialInterval: time.Second}) // want `use backoff.WithContext so retries observe cancellation`
+}
+
+// [BAD]: Retry with WithContext on only one branch
+//
+// The policy may reach Retry without WithContext.
+func badRetryConditionalContext(ctx context.Context, cancelable bool) error {
+	var b backoff.BackOff = backoff.NewExponentialBackOff()
+	if cancelable {
+		b = backoff.WithContext(b, ctx)
+	}
+	return backoff.Retry(fetch, b) // want `use backoff.WithContext so retries observe cancellation`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Retry with WithContext
+//
+// The policy stops when ctx is done.
+func goodRetryWithContext(ctx context.Context) error {
+	b := backoff.WithContext(backoff.NewExponentialBackOff(), ctx)
+	return backoff.Retry(fetch, b)
+}
+
+// [GOOD]: Retry with WithMaxRetries around WithContext
+//
+// WithContext anywhere in the wrapper chain is enough.
+func goodRetryMaxRetriesAroundContext(ctx context.Context) error {
+	b := backoff.WithMaxRetries(backoff.WithContext(backoff.NewExponentialBackOff(), ctx), 3)
+	return backoff.RetryNotify(fetch, b, logRetry)
+}
+
+// [GOOD]: Retry with a policy parameter
+//
+// Policies of unknown origin are assumed to be wrapped by the caller.
+func goodRetryPolicyParam(ctx context.Context, b backoff.BackOff) error {
+	return backoff.Retry(fetch, b)
+}
+
+// [GOOD]: Retry without ctx in scope
+//
+// There is no context to thread through.
+func goodRetryNoCtx() error {
+	return backoff.Retry(fetch, backoff.NewExponentialBackOff())
+}
+
+// [GOOD]: Retry with ignore directive
+//
+// The retry loop is deliberately detached from the request.
+func goodRetryIgnored(ctx context.Context) error {
+	//goroutinectx:ignore backoff - cleanup must finish even after cancellation
+	return backoff.Retry(fetch, backoff.NewExponentialBackOff())
+}
@@ -0,0 +1,86 @@
+// Package blockingio tests the blockingio checker with the default -blocking-funcs.
+package blockingio
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: io.Copy in goroutine
+//
+// The copy runs until EOF regardless of ctx cancellation.
+func badIOCopyInGoroutine(ctx context.Context, dst io.Writer, src io.Reader) {
+	go func() {
+		_ = ctx
+		_, _ = io.Copy(dst, src) // want `blocking I/O in goroutine cannot observe context cancellation`
+	}()
+}
+
+// [BAD]: io.ReadAll in goroutine
+//
+// Reading the whole stream blocks until EOF.
+func badIOReadAllInGoroutine(ctx context.Context, r io.Reader) {
+	go func() {
+		_ = ctx
+		data, _ := io.ReadAll(r) // want `blocking I/O in goroutine cannot observe context cancellation`
+		fmt.Println(len(data))
+	}()
+}
+
+// [BAD]: bufio.Scanner.Scan loop in goroutine
+//
+// Each Scan blocks on the underlying reader.
+func badScannerLoopInGoroutine(ctx context.Context, r io.Reader) {
+	go func() {
+		s := bufio.NewScanner(r)
+		for s.Scan() { // want `blocking I/O in goroutine cannot observe context cancellation`
+			if ctx.Err() != nil {
+				return
+			}
+			fmt.Println(s.Text())
+		}
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: io.Copy outside goroutine
+//
+// Only calls inside go statement closures are reported.
+func goodIOCopyOutsideGoroutine(ctx context.Context, dst io.Writer, src io.Reader) {
+	_ = ctx
+	_, _ = io.Copy(dst, src)
+}
+
+// [GOOD]: io.Copy in goroutine without ctx in scope
+//
+// Without a context there is nothing to observe.
+func goodIOCopyWithoutCtx(dst io.Writer, src io.Reader) {
+	go func() {
+		_, _ = io.Copy(dst, src)
+	}()
+}
+
+// [GOOD]: Non-blocking io function in goroutine
+//
+// Functions not in -blocking-funcs are not reported.
+func goodNonBlockingFunc(ctx context.Context, w io.Writer) {
+	go func() {
+		_ = ctx
+		_, _ = io.WriteString(w, "done")
+	}()
+}
+
+// [GOOD]: io.Copy in goroutine with ignore directive
+//
+// The source is known to be short-lived.
+func goodIOCopyIgnored(ctx context.Context, dst io.Writer, src io.Reader) {
+	go func() {
+		_ = ctx
+		_, _ = io.Copy(dst, src) //goroutinectx:ignore blockingio - src is an in-memory buffer
+	}()
+}
@@ -0,0 +1,32 @@
+// Package blockingiocustom tests the blockingio checker with -blocking-funcs=io.ReadFull.
+package blockingiocustom
+
+import (
+	"context"
+	"io"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Custom blocking function in goroutine
+//
+// io.ReadFull is listed in -blocking-funcs.
+func badCustomBlockingFunc(ctx context.Context, r io.Reader) {
+	go func() {
+		_ = ctx
+		buf := make([]byte, 16)
+		_, _ = io.ReadFull(r, buf) // want `blocking I/O in goroutine cannot observe context cancellation`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Default blocking function replaced by custom list
+//
+// -blocking-funcs replaces the defaults, so io.Copy is not reported.
+func goodDefaultReplaced(ctx context.Context, dst io.Writer, src io.Reader) {
+	go func() {
+		_ = ctx
+		_, _ = io.Copy(dst, src)
+	}()
+}
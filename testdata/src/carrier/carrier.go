@@ -1,7 +1,10 @@
 package carrier
 
 import (
+	"context"
+
 	"github.com/labstack/echo/v4"
+	"golang.org/x/sync/errgroup"
 
 	"log/slog"
 )
@@ -83,3 +86,157 @@ func badCarrierAsSecondParam(prefix string, c echo.Context) {
 		_ = prefix
 	}()
 }
+
+// [BAD]: Errgroup with only carrier in scope
+//
+// The message names the carrier variable, since no context.Context is in scope.
+func badErrgroupWithOnlyCarrier(c echo.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "c"`
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Errgroup with only carrier in scope
+//
+// The closure uses the carrier.
+func goodErrgroupWithOnlyCarrier(c echo.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		_ = c
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Errgroup calling a carrier method
+//
+// A method call on the captured carrier references it, so the closure uses it.
+func goodErrgroupCarrierMethodCall(c echo.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		c.Logger().Info("in errgroup")
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Errgroup branching on a carrier method result
+//
+// The only carrier usage is a method call in an if condition.
+func goodErrgroupCarrierMethodInCondition(c echo.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		if c.Get("user") == nil {
+			return nil
+		}
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Carrier before context param
+//
+// The first carrier or context parameter in the signature is named.
+func badCarrierBeforeCtxParam(c echo.Context, ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "c"`
+		slog.Info("no ctx")
+	}()
+}
+
+// [BAD]: Context param before carrier
+//
+// The first carrier or context parameter in the signature is named.
+func badCtxParamBeforeCarrier(ctx context.Context, c echo.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		slog.Info("no ctx")
+	}()
+}
+
+// ===== EMBEDDED CARRIER INTERFACE =====
+
+// requestCtx embeds the carrier interface, so it carries context as well.
+type requestCtx interface {
+	echo.Context
+}
+
+// tracedCtx embeds the carrier through another interface.
+type tracedCtx interface {
+	requestCtx
+	TraceID() string
+}
+
+// [BAD]: Interface embedding carrier
+//
+// A parameter whose interface embeds echo.Context is a carrier.
+func badEmbeddedCarrierInterface(c requestCtx) {
+	go func() { // want `goroutine does not propagate context "c"`
+		println("in goroutine")
+	}()
+}
+
+// [GOOD]: Interface embedding carrier
+//
+// The goroutine captures the embedding interface value.
+func goodEmbeddedCarrierInterface(c requestCtx) {
+	go func() {
+		_ = c
+	}()
+}
+
+// [BAD]: Interface embedding carrier transitively
+//
+// Embedding through another interface is followed.
+func badTransitivelyEmbeddedCarrierInterface(c tracedCtx) {
+	go func() { // want `goroutine does not propagate context "c"`
+		println("in goroutine")
+	}()
+}
+
+// [GOOD]: Interface embedding carrier transitively
+//
+// The goroutine captures the value and calls a method of the outer interface.
+func goodTransitivelyEmbeddedCarrierInterface(c tracedCtx) {
+	go func() {
+		println(c.TraceID())
+	}()
+}
+
+// [GOOD]: Captured embedding interface with context param
+//
+// Capturing the embedding interface counts as propagating the context in scope.
+func goodCapturedEmbeddedCarrierWithCtxParam(ctx context.Context, c requestCtx) {
+	go func() {
+		_ = c.Request()
+	}()
+}
+
+// ===== CARRIER TYPE PARAMETER =====
+
+// [BAD]: Type parameter constrained to carrier
+//
+// A parameter typed by a type parameter constrained to the carrier is a context in scope.
+func badTypeParamCarrier[E echo.Context](c E) {
+	go func() { // want `goroutine does not propagate context "c"`
+		println("in goroutine")
+	}()
+}
+
+// [GOOD]: Type parameter constrained to carrier
+//
+// The goroutine captures the carrier typed by the type parameter.
+func goodTypeParamCarrier[E echo.Context](c E) {
+	go func() {
+		_ = c
+	}()
+}
+
+// [BAD]: Type parameter constrained to interface embedding carrier
+//
+// Constraints embedding the carrier are followed too.
+func badTypeParamEmbeddedCarrier[E interface{ echo.Context }](c E) {
+	go func() { // want `goroutine does not propagate context "c"`
+		println("in goroutine")
+	}()
+}
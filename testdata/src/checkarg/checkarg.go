@@ -0,0 +1,95 @@
+// Package checkarg tests the //goroutinectx:check-arg directive.
+package checkarg
+
+import (
+	"context"
+	"fmt"
+)
+
+type pool struct{}
+
+//vt:helper
+func (*pool) Submit(name string, fn func()) {
+	go fn()
+}
+
+//vt:helper
+func submitPair(first func(), name string, second func()) {
+	go first()
+	go second()
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Marked argument without context
+//
+// The directive marks argument 1 as a goroutine callback.
+func badCheckArg(ctx context.Context, p *pool) {
+	//goroutinectx:check-arg 1
+	p.Submit("job", func() { // want `p.Submit\(\) argument 1 should use context "ctx"`
+		fmt.Println("no ctx")
+	})
+}
+
+// [BAD]: Marked argument from variable without context
+//
+// Variables holding func literals are traced like spawner arguments.
+func badCheckArgVariable(ctx context.Context, p *pool) {
+	fn := func() {
+		fmt.Println("no ctx")
+	}
+	p.Submit("job", fn) //goroutinectx:check-arg 1 // want `p.Submit\(\) argument 1 should use context "ctx"`
+}
+
+// [BAD]: Second of several marked arguments without context
+//
+// Every listed argument is checked.
+func badCheckArgMultiple(ctx context.Context) {
+	//goroutinectx:check-arg 0,2
+	submitPair(func() { // want `submitPair\(\) argument 2 should use context "ctx"`
+		_ = ctx
+	}, "pair", func() {
+		fmt.Println("no ctx")
+	})
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Marked argument with context
+//
+// The marked callback captures ctx.
+func goodCheckArg(ctx context.Context, p *pool) {
+	//goroutinectx:check-arg 1
+	p.Submit("job", func() {
+		_ = ctx
+	})
+}
+
+// [GOOD]: Unmarked call
+//
+// Without the directive the call is not checked.
+func goodCheckArgUnmarked(ctx context.Context, p *pool) {
+	p.Submit("job", func() {
+		fmt.Println("no ctx")
+	})
+}
+
+// [GOOD]: Marked call without context in scope
+//
+// Nothing to propagate when no context is available.
+func goodCheckArgNoCtx(p *pool) {
+	//goroutinectx:check-arg 1
+	p.Submit("job", func() {
+		fmt.Println("no ctx")
+	})
+}
+
+// [GOOD]: Out of range index
+//
+// Indices beyond the argument list are skipped.
+func goodCheckArgOutOfRange(ctx context.Context, p *pool) {
+	//goroutinectx:check-arg 5
+	p.Submit("job", func() {
+		fmt.Println("no ctx")
+	})
+}
@@ -0,0 +1,139 @@
+// Package closurestore tests the closurestore checker with
+// -closure-store-funcs=closurestore.Registry.Add,closurestore.OnShutdown.
+package closurestore
+
+import (
+	"context"
+	"sync"
+)
+
+// Registry keeps callbacks for the lifetime of the process.
+type Registry struct {
+	mu        sync.Mutex
+	callbacks []func()
+}
+
+//vt:helper
+func (r *Registry) Add(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.callbacks = append(r.callbacks, fn)
+}
+
+//vt:helper
+func (r *Registry) Run(fn func()) {
+	fn()
+}
+
+var registry Registry
+
+var shutdownHooks []func(context.Context)
+
+//vt:helper
+func OnShutdown(fn func(context.Context)) {
+	shutdownHooks = append(shutdownHooks, fn)
+}
+
+//vt:helper
+func use(ctx context.Context) {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Closure capturing ctx added to a registry
+//
+// The registry keeps the request context alive after the request ends.
+func badRegistryAdd(ctx context.Context) {
+	registry.Add(func() { // want `closure capturing request context stored in long-lived registry`
+		use(ctx)
+	})
+}
+
+// [BAD]: Closure through a variable
+//
+// A closure assigned to a variable before being stored is traced.
+func badClosureVariable(ctx context.Context) {
+	fn := func() {
+		use(ctx)
+	}
+	registry.Add(fn) // want `closure capturing request context stored in long-lived registry`
+}
+
+// [BAD]: Capture in a nested closure
+//
+// A context captured by a nested closure is captured by the stored closure too.
+func badNestedCapture(ctx context.Context) {
+	registry.Add(func() { // want `closure capturing request context stored in long-lived registry`
+		go func() {
+			use(ctx)
+		}()
+	})
+}
+
+// [BAD]: Package-level store function
+//
+// Functions are configured like methods, by package path and name.
+func badStoreFunc(ctx context.Context) {
+	OnShutdown(func(shutdownCtx context.Context) { // want `closure capturing request context stored in long-lived registry`
+		use(ctx)
+	})
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Closure without captured ctx
+//
+// A closure that does not capture the context keeps nothing alive.
+func goodNoCapture(ctx context.Context) {
+	use(ctx)
+	registry.Add(func() {})
+}
+
+// [GOOD]: Closure using its own ctx parameter
+//
+// The context passed by the registry when running the callback is not the request context.
+func goodOwnContextParam(ctx context.Context) {
+	use(ctx)
+	OnShutdown(func(shutdownCtx context.Context) {
+		use(shutdownCtx)
+	})
+}
+
+// [GOOD]: Non-store method
+//
+// Registry.Run calls the closure immediately and is not configured.
+func goodNonStoreMethod(ctx context.Context) {
+	registry.Run(func() {
+		use(ctx)
+	})
+}
+
+// [GOOD]: No context in scope
+//
+// Without a request context there is nothing to leak.
+func goodNoContextInScope() {
+	ctx := context.Background()
+	registry.Add(func() {
+		use(ctx)
+	})
+}
+
+// [GOOD]: Ignore directive
+//
+// The diagnostic is suppressed by an ignore directive.
+func goodIgnored(ctx context.Context) {
+	registry.Add(func() { //goroutinectx:ignore closurestore
+		use(ctx)
+	})
+}
+
+// [LIMITATION]: Closure from a helper
+//
+// Closures returned by helpers are not traced.
+func limitationClosureFromHelper(ctx context.Context) {
+	registry.Add(callbackFor(ctx))
+}
+
+//vt:helper
+func callbackFor(ctx context.Context) func() {
+	return func() { use(ctx) }
+}
@@ -338,3 +338,34 @@ func goodMapperMapErr(ctx context.Context) {
 		return *item * 2, nil
 	})
 }
+
+// ===== ERROR PATH CONTEXT USE =====
+
+// [GOOD]: pool.ErrorPool.Go with ctx only in a wrapped error return
+func goodErrorPoolGoWrappedCtxErr(ctx context.Context) {
+	p := &pool.ErrorPool{}
+	p.Go(func() error {
+		return fmt.Errorf("work canceled: %w", ctx.Err())
+	})
+	_ = p.Wait()
+}
+
+// [GOOD]: iter.MapErr with ctx only in a wrapped error return
+func goodMapErrWrappedCtxErr(ctx context.Context) {
+	items := []int{1, 2, 3}
+	_, _ = iter.MapErr(items, func(item *int) (int, error) {
+		if *item < 0 {
+			return 0, fmt.Errorf("item %d: %w", *item, ctx.Err())
+		}
+		return *item * 2, nil
+	})
+}
+
+// [BAD]: pool.ErrorPool.Go wrapping an error without ctx
+func badErrorPoolGoWrappedErr(ctx context.Context) {
+	p := &pool.ErrorPool{}
+	p.Go(func() error { // want `pool.ErrorPool.Go\(\) closure should use context "ctx"`
+		return fmt.Errorf("work failed: %w", fmt.Errorf("inner"))
+	})
+	_ = p.Wait()
+}
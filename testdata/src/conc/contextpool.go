@@ -0,0 +1,83 @@
+package conc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/conc/pool"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: pool.New().Go without ctx
+//
+// A plain Pool callback takes no context, so it must capture one.
+func badPoolNewGo(ctx context.Context) {
+	p := pool.New()
+	p.Go(func() { // want `pool.Pool.Go\(\) closure should use context "ctx"`
+		fmt.Println("no context")
+	})
+	p.Wait()
+}
+
+// [BAD]: pool.New().Go with variable callback without ctx
+//
+// The callback variable neither captures nor receives a context.
+func badPoolNewGoVariable(ctx context.Context) {
+	p := pool.New()
+	fn := func() {
+		fmt.Println("no context")
+	}
+	p.Go(fn) // want `pool.Pool.Go\(\) closure should use context "ctx"`
+	p.Wait()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: pool.New().WithContext(ctx).Go
+//
+// ContextPool injects ctx into the callback, so no capture is needed.
+func goodContextPoolFromNew(ctx context.Context) {
+	p := pool.New().WithContext(ctx)
+	p.Go(func(ctx context.Context) error {
+		return ctx.Err()
+	})
+	_ = p.Wait()
+}
+
+// [GOOD]: ContextPool callback ignoring its ctx param
+//
+// The callback is handed the pool context; leaving it unused is not a propagation gap.
+func goodContextPoolUnusedParam(ctx context.Context) {
+	p := pool.New().WithContext(ctx)
+	p.Go(func(_ context.Context) error {
+		fmt.Println("ignores injected ctx")
+		return nil
+	})
+	_ = p.Wait()
+}
+
+// [GOOD]: ContextPool with variable callback
+//
+// The callback variable receives ctx from the pool.
+func goodContextPoolVariable(ctx context.Context) {
+	p := pool.New().WithContext(ctx)
+	task := func(ctx context.Context) error {
+		return nil
+	}
+	p.Go(task)
+	_ = p.Wait()
+}
+
+// [GOOD]: ContextPool with named function
+//
+// A declared function taking ctx receives it from the pool.
+func goodContextPoolNamedFunc(ctx context.Context) {
+	p := pool.New().WithContext(ctx)
+	p.Go(contextPoolTask)
+	_ = p.Wait()
+}
+
+func contextPoolTask(ctx context.Context) error {
+	return ctx.Err()
+}
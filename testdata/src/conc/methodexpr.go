@@ -0,0 +1,76 @@
+package conc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/conc/iter"
+)
+
+// This file tests method expressions ((*T).Method) passed as conc callbacks.
+// The spawner supplies the receiver, so the method can only see a context
+// through a parameter or a context field of that receiver.
+
+type plainJob struct {
+	name string
+}
+
+func (j *plainJob) run() {
+	fmt.Println(j.name)
+}
+
+func (j *plainJob) runWithCtx(ctx context.Context) {
+	fmt.Println(j.name, ctx.Err())
+}
+
+type ctxJob struct {
+	ctx  context.Context
+	name string
+}
+
+func (j *ctxJob) run() {
+	fmt.Println(j.name, j.ctx.Err())
+}
+
+func (j *ctxJob) runIgnoringCtx() {
+	fmt.Println(j.name)
+}
+
+//goroutinectx:spawner //vt:helper
+func forEachWithCtx(ctx context.Context, jobs []*plainJob, fn func(*plainJob, context.Context)) {
+	for _, j := range jobs {
+		go fn(j, ctx)
+	}
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Method expression on a receiver without ctx
+//
+// The method reads no context from its receiver or parameters.
+func badMethodExprPlainJob(ctx context.Context, jobs []plainJob) {
+	iter.ForEach(jobs, (*plainJob).run) // want `iter.ForEach\(\) closure should use context "ctx"`
+}
+
+// [BAD]: Method expression ignoring the receiver ctx field
+//
+// The receiver carries a context but the method never reads it.
+func badMethodExprIgnoresCtxField(ctx context.Context, jobs []ctxJob) {
+	iter.ForEach(jobs, (*ctxJob).runIgnoringCtx) // want `iter.ForEach\(\) closure should use context "ctx"`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Method expression reading the receiver ctx field
+//
+// Each receiver passed by the spawner carries its context.
+func goodMethodExprReadsCtxField(ctx context.Context, jobs []ctxJob) {
+	iter.ForEach(jobs, (*ctxJob).run)
+}
+
+// [GOOD]: Method expression with a ctx parameter
+//
+// The spawner passes the context along with the receiver.
+func goodMethodExprCtxParam(ctx context.Context, jobs []*plainJob) {
+	forEachWithCtx(ctx, jobs, (*plainJob).runWithCtx)
+}
@@ -0,0 +1,93 @@
+package conc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/conc/pool"
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: errgroup closure spawning a plain Pool without ctx
+//
+// Neither the errgroup closure nor the pool callback uses ctx, and both are reported.
+func badErrgroupWithPlainPool(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		p := pool.New()
+		p.Go(func() { // want `pool.Pool.Go\(\) closure should use context "ctx"`
+			fmt.Println("no context")
+		})
+		p.Wait()
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Goroutine inside ContextPool callback nested in errgroup
+//
+// The pool callback's own ctx is in scope, and the goroutine drops it.
+func badGoroutineInNestedContextPool(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		p := pool.New().WithContext(ctx)
+		p.Go(func(ctx context.Context) error {
+			go func() { // want `goroutine does not propagate context "ctx"`
+				fmt.Println("no context")
+			}()
+			return nil
+		})
+		return p.Wait()
+	})
+	_ = g.Wait()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: errgroup closure spawning a ContextPool from ctx
+//
+// Passing ctx to WithContext uses it, and the pool callbacks receive their own ctx.
+func goodErrgroupWithContextPool(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		p := pool.New().WithContext(ctx)
+		p.Go(func(ctx context.Context) error {
+			return ctx.Err()
+		})
+		return p.Wait()
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Nested ContextPool callback ignoring its ctx param
+//
+// The injected ctx is self-satisfying even when unused.
+func goodErrgroupWithContextPoolUnusedParam(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		p := pool.New().WithContext(ctx)
+		p.Go(func(_ context.Context) error {
+			fmt.Println("ignores injected ctx")
+			return nil
+		})
+		return p.Wait()
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: ContextPool from errgroup.WithContext ctx
+//
+// The group context flows into the pool.
+func goodGroupCtxIntoContextPool(ctx context.Context) {
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		p := pool.New().WithContext(gctx)
+		p.Go(func(ctx context.Context) error {
+			return ctx.Err()
+		})
+		return p.Wait()
+	})
+	_ = g.Wait()
+}
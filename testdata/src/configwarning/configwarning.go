@@ -0,0 +1,15 @@
+// Package configwarning tests the configuration diagnostics with
+// -goroutine-deriver=github.com/my-example-app/telemetry/apm.NewGoroutineCtx
+// and -context-carriers=github.com/my-example-app/telemetry/apm.Carrier,
+// neither of which is declared by the apm package.
+package configwarning
+
+import (
+	"context"
+
+	"github.com/my-example-app/telemetry/apm" // want `-goroutine-deriver github.com/my-example-app/telemetry/apm.NewGoroutineCtx: not declared in package github.com/my-example-app/telemetry/apm, so it never matches` `-context-carriers github.com/my-example-app/telemetry/apm.Carrier: not declared in package github.com/my-example-app/telemetry/apm, so it never matches`
+)
+
+func annotate(ctx context.Context) {
+	apm.Annotate(ctx, "key", "value")
+}
@@ -0,0 +1,112 @@
+// Package contextaccessor tests -recognize-context-accessors.
+package contextaccessor
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/sync/errgroup"
+)
+
+//vt:helper
+func contextFromHeaders(h http.Header) context.Context {
+	_ = h
+	return context.Background()
+}
+
+//vt:helper
+func work(ctx context.Context) error {
+	_ = ctx
+	return nil
+}
+
+//goroutinectx:spawner //vt:helper
+func runTask(fn func()) {
+	go fn()
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Closure creating context.Background
+//
+// context.Background() is never an accessor, so the outer context is dropped.
+func badErrgroupBackgroundCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return work(context.Background())
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Accessor result discarded
+//
+// Calling an accessor without using the returned context does not count.
+func badErrgroupAccessorDiscarded(ctx context.Context, h http.Header) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		contextFromHeaders(h)
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Goroutine without any context
+//
+// A closure that neither captures nor obtains a context is still reported.
+func badGoroutineWithoutAnyCtx(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		_ = work(nil)
+	}()
+}
+
+// [BAD]: Spawner func discarding accessor result
+//
+// //goroutinectx:spawner funcs follow the same rule as errgroup closures.
+func badSpawnerAccessorDiscarded(ctx context.Context, h http.Header) {
+	runTask(func() { // want `runTask\(\) func argument should use context "ctx"`
+		contextFromHeaders(h)
+	})
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Errgroup closure obtaining context from accessor
+//
+// The closure genuinely has a context of its own, built from the headers.
+func goodErrgroupAccessorCtx(ctx context.Context, h http.Header) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		ctx := contextFromHeaders(h)
+		return work(ctx)
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Accessor result passed directly
+//
+// The accessor result is passed straight to a call.
+func goodErrgroupAccessorPassedDirectly(ctx context.Context, h http.Header) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		return work(contextFromHeaders(h))
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Goroutine using request context accessor
+//
+// http.Request.Context is an accessor outside the context package.
+func goodGoroutineRequestContext(ctx context.Context, r *http.Request) {
+	go func() {
+		_ = work(r.Context())
+	}()
+}
+
+// [GOOD]: Spawner func obtaining context from accessor
+//
+// //goroutinectx:spawner funcs follow the same rule as errgroup closures.
+func goodSpawnerAccessorCtx(ctx context.Context, h http.Header) {
+	runTask(func() {
+		_ = work(contextFromHeaders(h))
+	})
+}
@@ -0,0 +1,69 @@
+// Package ctxchansend tests the ctxchansend checker.
+package ctxchansend
+
+import "context"
+
+type sessionKey struct{}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Send derived ctx out of goroutine
+//
+// The parent receives a context built by the goroutine.
+func badSendDerivedCtx(ctx context.Context) context.Context {
+	ch := make(chan context.Context)
+	go func() {
+		ch <- buildCtx(ctx) // want `sending context out of a goroutine via channel is unusual`
+	}()
+	return <-ch
+}
+
+// [BAD]: Send captured ctx on send-only channel
+//
+// Directional channels of contexts are checked too.
+func badSendOnSendOnlyChan(ctx context.Context, out chan<- context.Context) {
+	go func() {
+		out <- ctx // want `sending context out of a goroutine via channel is unusual`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Send ctx outside goroutine
+//
+// Handing a context to a worker is the normal direction.
+func goodSendOutsideGoroutine(ctx context.Context) {
+	ch := make(chan context.Context, 1)
+	ch <- ctx
+	go func() {
+		<-ch
+	}()
+}
+
+// [GOOD]: Send non-context value from goroutine
+//
+// Only channels of context.Context are reported.
+func goodSendErrFromGoroutine(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ctx.Err()
+	}()
+	return <-errCh
+}
+
+// [GOOD]: Send ctx from goroutine with ignore directive
+//
+// The handoff is intentional.
+func goodSendCtxIgnored(ctx context.Context) context.Context {
+	ch := make(chan context.Context, 1)
+	go func() {
+		//goroutinectx:ignore ctxchansend - session context is created asynchronously
+		ch <- buildCtx(ctx)
+	}()
+	return <-ch
+}
+
+//vt:helper
+func buildCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionKey{}, "session")
+}
@@ -0,0 +1,112 @@
+// Package ctxfreevarstore tests the ctxfreevarstore checker.
+package ctxfreevarstore
+
+import (
+	"context"
+	"time"
+)
+
+//vt:helper
+func use(ctx context.Context) {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Nil guard on captured ctx
+//
+// The goroutine defaults the captured ctx, mutating the spawner's variable.
+func badNilGuard(ctx context.Context) {
+	go func() {
+		if ctx == nil {
+			ctx = context.Background() // want `reassigning captured context inside goroutine has surprising semantics`
+		}
+		use(ctx)
+	}()
+}
+
+// [BAD]: Captured ctx replaced by derived ctx
+//
+// Deriving into the captured variable also changes it for the spawner.
+func badDerivedIntoCaptured(ctx context.Context) {
+	go func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Second) // want `reassigning captured context inside goroutine has surprising semantics`
+		defer cancel()
+		use(ctx)
+	}()
+}
+
+// [BAD]: Captured local ctx
+//
+// Local context variables captured by the goroutine are shared too.
+func badCapturedLocal(parent context.Context) {
+	ctx := parent
+	go func() {
+		ctx = context.WithoutCancel(ctx) // want `reassigning captured context inside goroutine has surprising semantics`
+		use(ctx)
+	}()
+	use(ctx)
+}
+
+// [BAD]: Nested closure in goroutine
+//
+// A closure inside the goroutine assigning the captured ctx is reported too.
+func badNestedClosure(ctx context.Context) {
+	go func() {
+		reset := func() {
+			ctx = context.Background() // want `reassigning captured context inside goroutine has surprising semantics`
+		}
+		reset()
+		use(ctx)
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Shadowed ctx
+//
+// Declaring a new ctx inside the goroutine leaves the captured one alone.
+func goodShadowed(ctx context.Context) {
+	go func() {
+		ctx := ctx
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		use(ctx)
+	}()
+}
+
+// [GOOD]: Ctx passed as argument
+//
+// The goroutine receives its own copy as a parameter.
+func goodParameter(ctx context.Context) {
+	go func(ctx context.Context) {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		use(ctx)
+	}(ctx)
+}
+
+// [GOOD]: Reassigned outside goroutine
+//
+// Only assignments inside go statement closures are checked.
+func goodOutsideGoroutine(ctx context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	go func() {
+		use(ctx)
+	}()
+}
+
+// [GOOD]: Non-context captured variable
+//
+// Only context variables are checked.
+func goodNonContext(ctx context.Context) {
+	count := 0
+	go func() {
+		count = 1
+		use(ctx)
+	}()
+	_ = count
+}
@@ -0,0 +1,53 @@
+// Package ctxincache tests the ctxincache checker with the default -cache-set-funcs.
+package ctxincache
+
+import (
+	"context"
+	"sync"
+)
+
+type requestKey struct{}
+
+var sessions sync.Map
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Context stored in a sync.Map
+//
+// The request context outlives the request in the package-level map.
+func badSyncMapStore(ctx context.Context, id string) {
+	sessions.Store(id, ctx) // want `storing context.Context in a cache may leak request state`
+}
+
+// [BAD]: Context stored with LoadOrStore
+//
+// Every sync.Map method storing a value is listed by default.
+func badSyncMapLoadOrStore(ctx context.Context, id string) {
+	_, _ = sessions.LoadOrStore(id, ctx) // want `storing context.Context in a cache may leak request state`
+}
+
+// [BAD]: Derived context stored in a sync.Map
+//
+// Any context-typed value is reported, not only the one in scope.
+func badSyncMapStoreDerived(ctx context.Context, id string) {
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sessions.Store(id, child) // want `storing context.Context in a cache may leak request state`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Value extracted from the context stored in a sync.Map
+//
+// Only the needed value is cached, not the context itself.
+func goodSyncMapStoreValue(ctx context.Context, id string) {
+	sessions.Store(id, ctx.Value(requestKey{}))
+}
+
+// [GOOD]: Context read from a sync.Map
+//
+// Loading is not storing.
+func goodSyncMapLoad(ctx context.Context, id string) {
+	_ = ctx
+	_, _ = sessions.Load(id)
+}
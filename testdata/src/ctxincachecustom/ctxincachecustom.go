@@ -0,0 +1,45 @@
+// Package ctxincachecustom tests the ctxincache checker with
+// -cache-set-funcs=ctxincachecustom.Cache.Set.
+package ctxincachecustom
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache is a minimal key-value cache.
+type Cache struct {
+	items map[string]any
+}
+
+//vt:helper
+func (c *Cache) Set(key string, value any, ttl time.Duration) {
+	c.items[key] = value
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Context stored in a custom cache
+//
+// Cache.Set is listed in -cache-set-funcs.
+func badCustomCacheSet(ctx context.Context, c *Cache) {
+	c.Set("request", ctx, time.Minute) // want `storing context.Context in a cache may leak request state`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Default cache function replaced by custom list
+//
+// -cache-set-funcs replaces the defaults, so sync.Map.Store is not reported.
+func goodDefaultReplaced(ctx context.Context, m *sync.Map) {
+	m.Store("request", ctx)
+}
+
+// [GOOD]: Non-context value stored in a custom cache
+//
+// Only context-typed arguments are reported.
+func goodCustomCacheSetValue(ctx context.Context, c *Cache) {
+	_ = ctx
+	c.Set("request", "id", time.Minute)
+}
@@ -0,0 +1,96 @@
+// Package ctxinconstructor tests the ctxinconstructor checker.
+package ctxinconstructor
+
+import (
+	"context"
+	"time"
+)
+
+type Server struct {
+	ctx  context.Context
+	name string
+}
+
+type Client struct {
+	Ctx     context.Context
+	timeout time.Duration
+}
+
+type options struct {
+	ctx context.Context
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Constructor storing ctx in a composite literal
+//
+// Every later method call observes the first caller's context.
+func badNewServer(ctx context.Context, name string) *Server {
+	return &Server{ctx: ctx, name: name} // want `storing the constructor's context on the struct field is discouraged`
+}
+
+// [BAD]: Constructor storing ctx by field assignment
+//
+// Assigning the parameter to a field after construction is the same pattern.
+func badNewServerAssigned(ctx context.Context) *Server {
+	s := &Server{}
+	s.ctx = ctx // want `storing the constructor's context on the struct field is discouraged`
+	return s
+}
+
+// [BAD]: Constructor returning a struct value with an error
+//
+// Value results and extra results are constructors too.
+func badNewClient(ctx context.Context, timeout time.Duration) (Client, error) {
+	return Client{Ctx: ctx, timeout: timeout}, nil // want `storing the constructor's context on the struct field is discouraged`
+}
+
+// [BAD]: Unexported constructor
+//
+// The function name does not matter, only its parameter and result.
+func badNewOptions(ctx context.Context) *options {
+	o := options{
+		ctx: ctx, // want `storing the constructor's context on the struct field is discouraged`
+	}
+	return &o
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Constructor without storing ctx
+//
+// The context is only used during construction.
+func goodNewServerUsingCtx(ctx context.Context, name string) *Server {
+	_ = ctx.Err()
+	return &Server{name: name}
+}
+
+// [GOOD]: Constructor storing a derived context
+//
+// Only the parameter itself is reported.
+func goodNewServerDetached(ctx context.Context) *Server {
+	return &Server{ctx: context.WithoutCancel(ctx)}
+}
+
+// [GOOD]: Function storing ctx on a struct it does not return
+//
+// The struct is used locally and discarded.
+func goodRunWithOptions(ctx context.Context) error {
+	o := &options{ctx: ctx}
+	return o.ctx.Err()
+}
+
+// [GOOD]: Method storing ctx on its receiver
+//
+// Methods are not constructors.
+func (s *Server) goodReset(ctx context.Context) *Server {
+	s.ctx = ctx
+	return s
+}
+
+// [GOOD]: Constructor storing ctx with ignore directive
+//
+// Suppressed deliberately for a short-lived value.
+func goodNewServerIgnored(ctx context.Context) *Server {
+	return &Server{ctx: ctx} //goroutinectx:ignore ctxinconstructor
+}
@@ -0,0 +1,77 @@
+// Package ctxindto tests the ctxindto checker.
+package ctxindto
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Request DTO with context field
+//
+// The context can't be serialized and shouldn't travel with the payload.
+type badCreateUserRequest struct {
+	Ctx  context.Context // want `context.Context field in a JSON-serialized struct`
+	Name string          `json:"name"`
+}
+
+// [BAD]: Response DTO with ignored context field
+//
+// Even with json:"-", the struct is still a DTO and the context belongs in a parameter.
+type badUserResponse struct {
+	ID  int             `json:"id"`
+	Ctx context.Context `json:"-"` // want `context.Context field in a JSON-serialized struct`
+}
+
+// [BAD]: DTO with embedded context
+//
+// Embedding context.Context in a DTO is just as unserializable.
+type badEmbeddedCtxRequest struct {
+	context.Context        // want `context.Context field in a JSON-serialized struct`
+	Query           string `json:"query"`
+}
+
+// [BAD]: Anonymous DTO with context field
+//
+// Inline struct types are checked too.
+func badAnonymousDTO(ctx context.Context) ([]byte, error) {
+	payload := struct {
+		Ctx  context.Context // want `context.Context field in a JSON-serialized struct`
+		Name string          `json:"name"`
+	}{Ctx: ctx, Name: "x"}
+	return json.Marshal(payload)
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: DTO without context field
+//
+// The context is passed as a parameter instead.
+type goodCreateUserRequest struct {
+	Name string `json:"name"`
+}
+
+// [GOOD]: Non-DTO struct with context field
+//
+// Without json tags the struct isn't treated as serialized; other rules cover stored contexts.
+type goodWorker struct {
+	ctx  context.Context
+	name string
+}
+
+// [GOOD]: Struct with other tags only
+//
+// Only json tags mark a struct as a DTO.
+type goodDBRow struct {
+	Ctx  context.Context
+	Name string `db:"name"`
+}
+
+// [GOOD]: Ignored DTO field
+//
+// The directive suppresses the report.
+type goodIgnoredDTO struct {
+	Ctx  context.Context //goroutinectx:ignore ctxindto
+	Name string          `json:"name"`
+}
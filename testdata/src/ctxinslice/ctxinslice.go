@@ -0,0 +1,76 @@
+// Package ctxinslice tests the ctxinslice checker.
+package ctxinslice
+
+import (
+	"context"
+	"sync"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Append ctx to captured slice in goroutine
+//
+// The collected context outlives the goroutine that stored it.
+func badAppendCtxInGoroutine(ctx context.Context) {
+	var mu sync.Mutex
+	var ctxs []context.Context
+	go func() {
+		mu.Lock()
+		ctxs = append(ctxs, ctx) // want `context stored in a slice or map inside goroutine`
+		mu.Unlock()
+	}()
+	_ = ctxs
+}
+
+// [BAD]: Assign ctx into captured map in goroutine
+//
+// Keeping contexts in a map inside a goroutine is rarely intended.
+func badMapAssignCtxInGoroutine(ctx context.Context, id string) {
+	byID := map[string]context.Context{}
+	go func() {
+		byID[id] = ctx // want `context stored in a slice or map inside goroutine`
+	}()
+}
+
+// [BAD]: Assign ctx into captured slice index in goroutine
+//
+// Index assignments are checked as well as append.
+func badIndexAssignCtxInGoroutine(ctx context.Context) {
+	ctxs := make([]context.Context, 1)
+	go func() {
+		ctxs[0] = ctx // want `context stored in a slice or map inside goroutine`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Append ctx outside goroutine
+//
+// Only stores inside go statement closures are reported.
+func goodAppendCtxOutsideGoroutine(ctx context.Context) {
+	var ctxs []context.Context
+	ctxs = append(ctxs, ctx)
+	_ = ctxs
+}
+
+// [GOOD]: Append non-context values in goroutine
+//
+// Values derived from ctx are fine to collect.
+func goodAppendErrInGoroutine(ctx context.Context) {
+	var errs []error
+	go func() {
+		errs = append(errs, ctx.Err())
+	}()
+	_ = errs
+}
+
+// [GOOD]: Append ctx in goroutine with ignore directive
+//
+// The registry of contexts is intentional.
+func goodAppendCtxIgnored(ctx context.Context) {
+	var ctxs []context.Context
+	go func() {
+		ctxs = append(ctxs, ctx) //goroutinectx:ignore ctxinslice - registry of in-flight requests
+	}()
+	_ = ctxs
+}
@@ -0,0 +1,75 @@
+// Package ctxmapkey tests the ctxmapkey checker.
+package ctxmapkey
+
+import (
+	"context"
+	"net/http"
+)
+
+//vt:helper
+func requestID(ctx context.Context) string { return "" }
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Context as map key in assignment
+//
+// Derived contexts are distinct keys, so entries are never found again.
+func badAssignCtxKey(ctx context.Context, seen map[context.Context]bool) {
+	seen[ctx] = true // want `context.Context used as a map key`
+}
+
+// [BAD]: Context as map key in lookup
+//
+// Lookups by context are reported like assignments.
+func badLookupCtxKey(ctx context.Context, seen map[context.Context]bool) bool {
+	return seen[ctx] // want `context.Context used as a map key`
+}
+
+// [BAD]: Context as map key in comma-ok lookup
+//
+// The comma-ok form is still a lookup by context.
+func badCommaOkLookupCtxKey(ctx context.Context, cache map[context.Context]string) string {
+	v, ok := cache[ctx] // want `context.Context used as a map key`
+	if !ok {
+		return ""
+	}
+	return v
+}
+
+// [BAD]: Request context as map key in handler
+//
+// Reported even though no context variable is in scope.
+func badRequestCtxKey(w http.ResponseWriter, r *http.Request) {
+	counts := make(map[context.Context]int)
+	counts[r.Context()]++ // want `context.Context used as a map key`
+}
+
+// [BAD]: Context as key of a map with interface keys
+//
+// The key expression is a context even if the map accepts any key.
+func badAnyKeyCtx(ctx context.Context, m map[any]int) {
+	m[ctx] = 1 // want `context.Context used as a map key`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Map keyed by a value derived from ctx
+//
+// Stable identifiers make good keys.
+func goodRequestIDKey(ctx context.Context, seen map[string]bool) {
+	seen[requestID(ctx)] = true
+}
+
+// [GOOD]: Slice of contexts indexed by position
+//
+// Only map indexes are reported.
+func goodSliceIndex(ctxs []context.Context) context.Context {
+	return ctxs[0]
+}
+
+// [GOOD]: Context map key with ignore directive
+//
+// Suppressed deliberately for a short-lived identity set.
+func goodIgnoredCtxKey(ctx context.Context, seen map[context.Context]bool) {
+	seen[ctx] = true //goroutinectx:ignore ctxmapkey
+}
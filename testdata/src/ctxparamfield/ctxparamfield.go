@@ -0,0 +1,83 @@
+// Package ctxparamfield tests the ctxparamfield checker.
+package ctxparamfield
+
+import (
+	"context"
+)
+
+type server struct {
+	ctx  context.Context
+	name string
+}
+
+type plain struct {
+	name string
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Method reading receiver ctx field with unused ctx param
+//
+// The ctx parameter is ignored in favor of s.ctx.
+func (s *server) badUnusedParamFieldUsed(ctx context.Context) error { // want `ctx parameter shadowed by s.ctx field; one is unused`
+	return query(s.ctx)
+}
+
+// [BAD]: Value receiver with differently named param
+//
+// The parameter name is reported as declared.
+func (s server) badValueReceiver(c context.Context) error { // want `c parameter shadowed by s.ctx field; one is unused`
+	return query(s.ctx)
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Method using its ctx param
+//
+// The parameter is used; the receiver field is not.
+func (s *server) goodParamUsed(ctx context.Context) error {
+	return query(ctx)
+}
+
+// [GOOD]: Method using both param and field
+//
+// Both are used, so neither is dead.
+func (s *server) goodBothUsed(ctx context.Context) error {
+	if err := query(ctx); err != nil {
+		return err
+	}
+	return query(s.ctx)
+}
+
+// [GOOD]: Unused ctx param without field access
+//
+// The receiver's ctx field isn't read, so there's no conflict.
+func (s *server) goodFieldNotRead(ctx context.Context) string {
+	return s.name
+}
+
+// [GOOD]: Receiver without ctx field
+//
+// Only context-typed receiver fields count.
+func (p *plain) goodNoCtxField(ctx context.Context) string {
+	return p.name
+}
+
+// [GOOD]: Blank ctx param
+//
+// A blank parameter is explicitly unused.
+func (s *server) goodBlankParam(_ context.Context) error {
+	return query(s.ctx)
+}
+
+// [GOOD]: Ignored method
+//
+// The directive suppresses the report.
+func (s *server) goodIgnored(ctx context.Context) error { //goroutinectx:ignore ctxparamfield
+	return query(s.ctx)
+}
+
+//vt:helper
+func query(ctx context.Context) error {
+	return ctx.Err()
+}
@@ -0,0 +1,100 @@
+// Package ctxrequired tests the ctxrequired checker.
+package ctxrequired
+
+import (
+	"context"
+	"log/slog"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Background instead of ctx
+//
+// The request fields are extracted from an empty context.
+func badBackground(ctx context.Context) {
+	slog.Info("handled", requestFields(context.Background())...) // want `pass context "ctx" to requestFields`
+}
+
+// [BAD]: TODO instead of ctx
+//
+// context.TODO() carries no request values either.
+func badTODO(ctx context.Context) {
+	slog.Info("handled", requestFields(context.TODO())...) // want `pass context "ctx" to requestFields`
+}
+
+// [BAD]: Nil instead of ctx
+//
+// A nil context drops the request fields entirely.
+func badNil(ctx context.Context) {
+	slog.Info("handled", requestFields(nil)...) // want `pass context "ctx" to requestFields`
+}
+
+// [BAD]: Method argument without ctx
+//
+// The configured argument index selects the context parameter.
+func badMethodArg(ctx context.Context, l Logger) {
+	slog.Info("handled", l.Fields("handler", context.Background())...) // want `pass context "ctx" to Fields`
+}
+
+// [BAD]: Background in goroutine
+//
+// The goroutine inherits ctx from the enclosing scope.
+func badBackgroundInGoroutine(ctx context.Context) {
+	go func() {
+		slog.Info("async", requestFields(context.Background())...) // want `pass context "ctx" to requestFields`
+		_ = ctx
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Ctx passed
+//
+// The in-scope context is passed.
+func goodCtxPassed(ctx context.Context) {
+	slog.Info("handled", requestFields(ctx)...)
+}
+
+// [GOOD]: Derived ctx passed
+//
+// A context derived from the in-scope context keeps the request values.
+func goodDerivedCtxPassed(ctx context.Context) {
+	reqCtx := context.WithValue(ctx, requestIDKey{}, "id")
+	slog.Info("handled", requestFields(reqCtx)...)
+}
+
+// [GOOD]: Method argument with ctx
+//
+// The context is passed at the configured argument index.
+func goodMethodArg(ctx context.Context, l Logger) {
+	slog.Info("handled", l.Fields("handler", ctx)...)
+}
+
+// [GOOD]: No ctx in scope
+//
+// Without a context in scope there is nothing to pass.
+func goodNoCtxInScope() {
+	slog.Info("startup", requestFields(context.Background())...)
+}
+
+// [GOOD]: Background with ignore directive
+//
+// The ignore directive suppresses the report.
+func goodBackgroundIgnored(ctx context.Context) {
+	slog.Info("handled", requestFields(context.Background())...) //goroutinectx:ignore ctxrequired
+}
+
+type requestIDKey struct{}
+
+//vt:helper
+func requestFields(ctx context.Context) []any {
+	return []any{"request_id", ctx.Value(requestIDKey{})}
+}
+
+// Logger extracts log fields.
+type Logger struct{}
+
+//vt:helper
+func (Logger) Fields(name string, ctx context.Context) []any {
+	return []any{"name", name, "request_id", ctx.Value(requestIDKey{})}
+}
@@ -0,0 +1,119 @@
+// Package ctxvalueassert tests the ctxvalueassert checker.
+package ctxvalueassert
+
+import (
+	"context"
+	"net/http"
+)
+
+type ctxKey struct{}
+
+type user struct {
+	name string
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Single-value assertion on ctx.Value
+//
+// Panics when the key is missing from the context.
+func badSingleValueAssert(ctx context.Context) string {
+	u := ctx.Value(ctxKey{}).(*user) // want `type assertion on ctx.Value result should use comma-ok`
+	return u.name
+}
+
+// [BAD]: Assertion used directly in an expression
+//
+// The asserted value is used without being assigned.
+func badAssertInExpression(ctx context.Context) string {
+	return ctx.Value(ctxKey{}).(*user).name // want `type assertion on ctx.Value result should use comma-ok`
+}
+
+// [BAD]: Assertion on request context in handler
+//
+// Reported even though no context variable is in scope.
+func badRequestContextAssert(w http.ResponseWriter, r *http.Request) {
+	u := r.Context().Value(ctxKey{}).(*user) // want `type assertion on ctx.Value result should use comma-ok`
+	_, _ = w.Write([]byte(u.name))
+}
+
+// [BAD]: Assertion inside goroutine
+//
+// The goroutine captures ctx but would panic on a missing value.
+func badAssertInGoroutine(ctx context.Context) {
+	go func() {
+		u := ctx.Value(ctxKey{}).(*user) // want `type assertion on ctx.Value result should use comma-ok`
+		_ = u
+	}()
+}
+
+// [BAD]: Assertion passed as call argument
+//
+// Call arguments only accept the single-value form.
+func badAssertAsArgument(ctx context.Context) {
+	greet(ctx.Value(ctxKey{}).(string)) // want `type assertion on ctx.Value result should use comma-ok`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Comma-ok assertion
+//
+// A missing value yields ok == false instead of panicking.
+func goodCommaOk(ctx context.Context) string {
+	u, ok := ctx.Value(ctxKey{}).(*user)
+	if !ok {
+		return ""
+	}
+	return u.name
+}
+
+// [GOOD]: Comma-ok in var declaration
+//
+// Declarations with two names are also comma-ok.
+func goodCommaOkVar(ctx context.Context) bool {
+	var _, ok = ctx.Value(ctxKey{}).(*user)
+	return ok
+}
+
+// [GOOD]: Parenthesized comma-ok assertion
+//
+// Parentheses around the assertion don't change its form.
+func goodCommaOkParen(ctx context.Context) bool {
+	_, ok := (ctx.Value(ctxKey{}).(*user))
+	return ok
+}
+
+// [GOOD]: Type switch on ctx.Value
+//
+// Type switches never panic.
+func goodTypeSwitch(ctx context.Context) string {
+	switch v := ctx.Value(ctxKey{}).(type) {
+	case *user:
+		return v.name
+	default:
+		return ""
+	}
+}
+
+// [GOOD]: Assertion on non-context Value method
+//
+// Only context.Context.Value is checked.
+func goodNonContextValue(m valuer) string {
+	return m.Value("k").(string)
+}
+
+// [GOOD]: Ignored assertion
+//
+// The directive suppresses the report.
+func goodIgnoredAssert(ctx context.Context) string {
+	return ctx.Value(ctxKey{}).(string) //goroutinectx:ignore ctxvalueassert
+}
+
+//vt:helper
+func greet(name string) {
+	_ = name
+}
+
+type valuer interface {
+	Value(key any) any
+}
@@ -0,0 +1,71 @@
+// Package customchecker tests checkers registered through the public API.
+package customchecker
+
+import (
+	"context"
+)
+
+//vt:helper
+func fetch(args ...any) {}
+
+//vt:helper
+func worker(ctx context.Context) {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Bespoke API called without context
+//
+// The registered call checker flags fetch calls that do not receive a context.
+func badFetch(ctx context.Context) {
+	fetch("https://example.com") // want `fetch should receive context "ctx"`
+}
+
+// [BAD]: Bespoke API called without context inside goroutine
+//
+// Registered checkers inherit the enclosing context scope like built-in checkers.
+func badFetchInGoroutine(ctx context.Context) {
+	go func() {
+		_ = ctx
+		fetch("https://example.com") // want `fetch should receive context "ctx"`
+	}()
+}
+
+// [BAD]: Named function spawned
+//
+// The go statement checker passed through Options requires closures.
+func badNamedGo(ctx context.Context) {
+	go worker(ctx) // want `spawn a closure instead of a named function`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Bespoke API called with context
+//
+// The argument references the context in scope.
+func goodFetch(ctx context.Context) {
+	fetch(ctx, "https://example.com")
+}
+
+// [GOOD]: No context in scope
+//
+// Custom checkers only run where a context is available.
+func goodFetchNoCtx() {
+	fetch("https://example.com")
+}
+
+// [GOOD]: Ignore directive for custom checker
+//
+// Custom checker names work with ignore directives.
+func goodFetchIgnored(ctx context.Context) {
+	//goroutinectx:ignore legacyfetch
+	fetch("https://example.com")
+}
+
+// [GOOD]: Closure spawned
+//
+// The closure uses the context.
+func goodClosureGo(ctx context.Context) {
+	go func() {
+		worker(ctx)
+	}()
+}
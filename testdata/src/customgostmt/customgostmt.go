@@ -0,0 +1,30 @@
+// Package customgostmt tests go statement checkers registered through RegisterGoStmt.
+package customgostmt
+
+import (
+	"context"
+)
+
+//vt:helper
+func legacyWorker(ctx context.Context) {}
+
+//vt:helper
+func worker(ctx context.Context) {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Deprecated worker spawned
+//
+// The registered go statement checker flags goroutines running legacyWorker.
+func badLegacyWorkerGo(ctx context.Context) {
+	go legacyWorker(ctx) // want `legacyWorker is deprecated; spawn worker instead`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Replacement worker spawned
+//
+// Other functions are left to the built-in checkers.
+func goodWorkerGo(ctx context.Context) {
+	go worker(ctx)
+}
@@ -0,0 +1,63 @@
+// Package derivemissing tests -explain-missing-deriver wording.
+// Run with -goroutine-deriver=github.com/newrelic/go-agent/v3/newrelic.Transaction.NewGoroutine+github.com/newrelic/go-agent/v3/newrelic.NewContext,github.com/my-example-app/telemetry/apm.NewGoroutineContext
+package derivemissing
+
+import (
+	"context"
+
+	"github.com/my-example-app/telemetry/apm"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Nested 3-level, each level partial
+//
+// Each level is checked on its own and names the spec it is missing.
+func badNested3LevelPartialExplained(ctx context.Context, txn *newrelic.Transaction) {
+	go func() { // want `goroutine missing deriver newrelic.NewContext \(NewGoroutine present\)`
+		txn = txn.NewGoroutine() // Only first deriver
+		go func() { // want `goroutine missing deriver newrelic.Transaction.NewGoroutine \(NewContext present\)`
+			ctx = newrelic.NewContext(ctx, txn) // Only second deriver
+			go func() { // want "goroutine should call github.com/newrelic/go-agent/v3/newrelic.Transaction.NewGoroutine\\+github.com/newrelic/go-agent/v3/newrelic.NewContext,github.com/my-example-app/telemetry/apm.NewGoroutineContext to derive context"
+				_ = ctx // Neither deriver
+			}()
+			_ = ctx
+		}()
+		_ = txn
+	}()
+}
+
+// [BAD]: Partial AND group in defer
+//
+// Deferred calls don't count as present, so the generic message is kept.
+func badPartialOnlyInDefer(ctx context.Context, txn *newrelic.Transaction) {
+	go func() { // want "goroutine should call github.com/newrelic/go-agent/v3/newrelic.Transaction.NewGoroutine\\+github.com/newrelic/go-agent/v3/newrelic.NewContext,github.com/my-example-app/telemetry/apm.NewGoroutineContext to derive context"
+		defer txn.NewGoroutine()
+		_ = ctx
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Complete AND group
+//
+// Both specs of the AND group are called.
+func goodCompleteAndGroup(ctx context.Context, txn *newrelic.Transaction) {
+	go func() {
+		txn = txn.NewGoroutine()
+		ctx = newrelic.NewContext(ctx, txn)
+		_ = ctx
+	}()
+}
+
+// [GOOD]: Partial AND group with another OR alternative
+//
+// The OR alternative is satisfied, so the partial AND group doesn't matter.
+func goodPartialAndWithOrAlternative(ctx context.Context, txn *newrelic.Transaction) {
+	go func() {
+		txn = txn.NewGoroutine()
+		ctx = apm.NewGoroutineContext(ctx)
+		_ = ctx
+	}()
+}
@@ -0,0 +1,27 @@
+// Package dirconfig tests per-directory .goroutinectx.yaml overrides.
+// This directory has no config file, so flag defaults apply.
+package dirconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// [BAD]: Goroutine without ctx
+//
+// The goroutine checker is enabled by default.
+func badGoroutineDefault(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		fmt.Println("no ctx")
+	}()
+}
+
+// [GOOD]: time.Tick with opt-in rule off
+//
+// The timetick checker is opt-in and not enabled here.
+func goodTimeTickDefault(ctx context.Context) {
+	for range time.Tick(time.Second) {
+		fmt.Println("tick")
+	}
+}
@@ -0,0 +1,16 @@
+// Package legacy disables the goroutine checker via .goroutinectx.yaml.
+package legacy
+
+import (
+	"context"
+	"fmt"
+)
+
+// [GOOD]: Goroutine without ctx in disabled directory
+//
+// The goroutine checker is disabled for this directory.
+func goodGoroutineDisabled(ctx context.Context) {
+	go func() {
+		fmt.Println("no ctx")
+	}()
+}
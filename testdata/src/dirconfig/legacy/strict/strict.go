@@ -0,0 +1,26 @@
+// Package strict re-enables the goroutine checker disabled by its parent.
+package strict
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// [BAD]: Goroutine without ctx in re-enabled directory
+//
+// The nearer config re-enables the checker disabled by the parent config.
+func badGoroutineReenabled(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		fmt.Println("no ctx")
+	}()
+}
+
+// [BAD]: time.Tick with opt-in rule enabled by config
+//
+// The timetick checker is enabled without its flag.
+func badTimeTickEnabled(ctx context.Context) {
+	for range time.Tick(time.Second) { // want `avoid time.Tick in context-aware code; use time.NewTicker with ctx.Done\(\)`
+		fmt.Println("tick")
+	}
+}
@@ -0,0 +1,27 @@
+// Package signals enables the signal checker via .goroutinectx.yaml.
+package signals
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+)
+
+// [BAD]: signal.Notify with opt-in rule enabled by config
+//
+// The signal checker is enabled for this directory only.
+func badSignalNotify(ctx context.Context) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt) // want `use signal.NotifyContext with context "ctx" instead of signal.Notify`
+	<-ch
+}
+
+// [GOOD]: time.Tick with opt-in rule enabled elsewhere
+//
+// The timetick checker enabled under legacy/strict does not apply here.
+func goodTimeTickNotEnabled(ctx context.Context) {
+	for range time.Tick(time.Second) {
+		<-ctx.Done()
+	}
+}
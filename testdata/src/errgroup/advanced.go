@@ -240,3 +240,295 @@ func goodDeferNestedClosure(ctx context.Context) {
 	})
 	_ = g.Wait()
 }
+
+// ===== BUILDER METHOD CHAIN =====
+
+//vt:helper
+func newErrgroup(ctx context.Context) *errgroup.Group {
+	g, _ := errgroup.WithContext(ctx)
+	return g
+}
+
+// [BAD]: Go called on a freshly returned group
+//
+// The receiver is a call result rather than a variable.
+func badChainedGroupGo(ctx context.Context) {
+	newErrgroup(ctx).Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		fmt.Println("no ctx")
+		return nil
+	})
+}
+
+// [GOOD]: Go called on a freshly returned group
+//
+// The closure uses ctx.
+func goodChainedGroupGo(ctx context.Context) {
+	newErrgroup(ctx).Go(func() error {
+		return ctx.Err()
+	})
+}
+
+// [BAD]: TryGo called on a group read from a slice
+//
+// The receiver is resolved from its type, whatever the expression.
+func badChainedGroupTryGo(ctx context.Context) {
+	groups := []*errgroup.Group{new(errgroup.Group)}
+	groups[0].TryGo(func() error { // want `errgroup.Group.TryGo\(\) closure should use context "ctx"`
+		fmt.Println("no ctx")
+		return nil
+	})
+}
+
+// ===== ERROR PATH CONTEXT USE =====
+
+// [GOOD]: Ctx only used in a wrapped error return
+//
+// ctx.Err() inside fmt.Errorf arguments counts as using ctx.
+func goodWrappedCtxErrReturn(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		return fmt.Errorf("work canceled: %w", ctx.Err())
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Ctx only used in a wrapped error return on a conditional path
+//
+// The error path is the only place ctx appears.
+func goodWrappedCtxErrReturnConditional(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		if err := runStep(); err != nil {
+			return fmt.Errorf("work failed: %w", ctx.Err())
+		}
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Wrapped error return without ctx
+//
+// Wrapping an unrelated error does not use ctx.
+func badWrappedErrReturnWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		if err := runStep(); err != nil {
+			return fmt.Errorf("work failed: %w", err)
+		}
+		return nil
+	})
+	_ = g.Wait()
+}
+
+//vt:helper
+func runStep() error {
+	return nil
+}
+
+// ===== DELEGATE TO LOCAL CLOSURE =====
+
+// [GOOD]: Closure delegating to a local closure that uses ctx
+//
+// The helper closure captures ctx, so calling it propagates the context.
+func goodDelegateToLocalClosure(ctx context.Context) {
+	g := new(errgroup.Group)
+	help := func() error {
+		return fmt.Errorf("help: %w", ctx.Err())
+	}
+	g.Go(func() error {
+		return help()
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Closure delegating through a chain of local closures
+//
+// Each closure in the chain is followed until one uses ctx.
+func goodDelegateToLocalClosureChain(ctx context.Context) {
+	g := new(errgroup.Group)
+	inner := func() error {
+		return ctx.Err()
+	}
+	outer := func() error {
+		return inner()
+	}
+	g.Go(func() error {
+		return outer()
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Closure delegating to a local closure without ctx
+//
+// The helper closure does not use ctx either.
+func badDelegateToLocalClosure(ctx context.Context) {
+	g := new(errgroup.Group)
+	help := func() error {
+		fmt.Println("no ctx")
+		return nil
+	}
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return help()
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Closure delegating to a conditionally replaced local closure
+//
+// The replacement closure does not use ctx.
+func badDelegateToReassignedLocalClosure(ctx context.Context, legacy bool) {
+	g := new(errgroup.Group)
+	help := func() error {
+		return ctx.Err()
+	}
+	if legacy {
+		help = func() error {
+			return nil
+		}
+	}
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return help()
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Closure delegating to mutually recursive local closures without ctx
+//
+// The cycle is detected and the closures are treated as not using ctx.
+func badDelegateToRecursiveLocalClosures(ctx context.Context) {
+	g := new(errgroup.Group)
+	var ping, pong func(n int) error
+	ping = func(n int) error {
+		if n == 0 {
+			return nil
+		}
+		return pong(n - 1)
+	}
+	pong = func(n int) error {
+		return ping(n)
+	}
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return ping(3)
+	})
+	_ = g.Wait()
+}
+
+// [LIMITATION]: Closure delegating through a deep chain of local closures
+//
+// Only a few levels of local closures are followed.
+func limitationDelegateToDeepLocalClosureChain(ctx context.Context) {
+	g := new(errgroup.Group)
+	l5 := func() error { return ctx.Err() }
+	l4 := func() error { return l5() }
+	l3 := func() error { return l4() }
+	l2 := func() error { return l3() }
+	l1 := func() error { return l2() }
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return l1()
+	})
+	_ = g.Wait()
+}
+
+// ===== CONDITIONALLY ASSIGNED FUNC VARIABLE =====
+
+// [BAD]: Func variable assigned from closures on both branches, one without ctx
+//
+// The spawned value may be the closure that drops ctx.
+func badPhiClosureOneBranchNoCtx(ctx context.Context, fast bool) {
+	g := new(errgroup.Group)
+	withCtx := func() error { return ctx.Err() }
+	withoutCtx := func() error { return nil }
+	var fn func() error
+	if fast {
+		fn = withCtx
+	} else {
+		fn = withoutCtx
+	}
+	g.Go(fn) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [BAD]: Func variable assigned a package func on one branch
+//
+// The package func cannot capture ctx.
+func badPhiPackageFuncBranch(ctx context.Context, fast bool) {
+	g := new(errgroup.Group)
+	withCtx := func() error { return ctx.Err() }
+	var fn func() error
+	if fast {
+		fn = withCtx
+	} else {
+		fn = runStep
+	}
+	g.Go(fn) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [GOOD]: Func variable assigned from closures on both branches, all with ctx
+//
+// Every value reaching the call captures ctx.
+func goodPhiClosureBothBranchesCtx(ctx context.Context, fast bool) {
+	g := new(errgroup.Group)
+	quick := func() error { return ctx.Err() }
+	slow := func() error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	var fn func() error
+	if fast {
+		fn = quick
+	} else {
+		fn = slow
+	}
+	g.Go(fn)
+	_ = g.Wait()
+}
+
+// ===== GROUP IN STRUCT FIELD =====
+
+type app struct {
+	g *errgroup.Group
+}
+
+type appValue struct {
+	g errgroup.Group
+}
+
+// [BAD]: Go on a pointer field group without ctx
+//
+// The group is reached through a receiver field, not a local variable.
+func (a *app) badFieldGroupGo(ctx context.Context) {
+	a.g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		fmt.Println("no ctx")
+		return nil
+	})
+}
+
+// [GOOD]: Go on a pointer field group with ctx
+//
+// The closure captures ctx.
+func (a *app) goodFieldGroupGo(ctx context.Context) {
+	a.g.Go(func() error {
+		return ctx.Err()
+	})
+}
+
+// [BAD]: Go on a value field group without ctx
+//
+// Method values on an addressable field are matched too.
+func (a *appValue) badValueFieldGroupGo(ctx context.Context) {
+	a.g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		fmt.Println("no ctx")
+		return nil
+	})
+}
+
+// [BAD]: Go on a nested field group without ctx
+//
+// Selector chains resolve to the group's type.
+func badNestedFieldGroupGo(ctx context.Context, s struct{ inner *app }) {
+	s.inner.g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		fmt.Println("no ctx")
+		return nil
+	})
+}
@@ -1004,3 +1004,567 @@ func goodConditionalReassignAllUseCtx(ctx context.Context) {
 	g.Go(fn) // OK - all assignments use ctx
 	_ = g.Wait()
 }
+
+// ===== MULTI-RETURN FACTORY PATTERNS =====
+
+//vt:helper
+func makeNamedWorker() (string, func() error) {
+	return "worker", func() error {
+		fmt.Println("no ctx")
+		return nil
+	}
+}
+
+//vt:helper
+func makeNamedWorkerWithCtx(ctx context.Context) (string, func() error) {
+	return "worker", func() error {
+		_ = ctx
+		return nil
+	}
+}
+
+// [BAD]: Multi-return factory - func is second value without ctx
+//
+// The closure returned as the second value does not use context.
+func badMultiReturnFactoryWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	name, fn := makeNamedWorker()
+	fmt.Println(name)
+	g.Go(fn) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [GOOD]: Multi-return factory - called with ctx
+//
+// Factory receives context, so the returned closure can use it.
+func goodMultiReturnFactoryWithCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	_, fn := makeNamedWorkerWithCtx(ctx)
+	g.Go(fn)
+	_ = g.Wait()
+}
+
+// [GOOD]: Multi-return local factory - second value captures ctx
+//
+// Local factory returns a closure capturing ctx as its second value.
+func goodMultiReturnLocalFactoryCapturesCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	build := func() (string, func() error) {
+		return "worker", func() error {
+			_ = ctx
+			return nil
+		}
+	}
+	_, fn := build()
+	g.Go(fn)
+	_ = g.Wait()
+}
+
+// [BAD]: Multi-return local factory - only first value captures ctx
+//
+// The result index is resolved, so the ctx-capturing first value does not count.
+func badMultiReturnLocalFactoryFirstCapturesCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	build := func() (func() error, func() error) {
+		withCtx := func() error {
+			_ = ctx
+			return nil
+		}
+		withoutCtx := func() error {
+			fmt.Println("no ctx")
+			return nil
+		}
+		return withCtx, withoutCtx
+	}
+	_, fn := build()
+	g.Go(fn) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// ===== TYPE PARAMETER METHOD VALUE PATTERNS =====
+
+// ctxFieldRunner has a single implementor in this package (ctxFieldJob).
+type ctxFieldRunner interface {
+	RunWithCtxField() error
+}
+
+// ctxFieldJob carries the context as a field.
+type ctxFieldJob struct {
+	ctx  context.Context
+	skip bool
+}
+
+func (j *ctxFieldJob) RunWithCtxField() error {
+	if j.skip {
+		return nil
+	}
+	return j.ctx.Err()
+}
+
+// ctxlessRunner has a single implementor in this package (ctxlessJob).
+type ctxlessRunner interface {
+	RunWithoutCtxField() error
+}
+
+// ctxlessJob has no context field.
+type ctxlessJob struct {
+	name string
+}
+
+func (j ctxlessJob) RunWithoutCtxField() error {
+	fmt.Println(j.name)
+	return nil
+}
+
+// ambiguousRunner has several implementors in this package.
+type ambiguousRunner interface {
+	RunAmbiguous() error
+}
+
+type ambiguousJobA struct{}
+
+func (ambiguousJobA) RunAmbiguous() error { return nil }
+
+type ambiguousJobB struct{}
+
+func (ambiguousJobB) RunAmbiguous() error { return nil }
+
+// [GOOD]: Type parameter method value - sole implementor reads ctx field
+//
+// The constraint has a single concrete implementor whose method uses its context field.
+func goodTypeParamMethodValueCtxField[R ctxFieldRunner](ctx context.Context, r R) {
+	g := new(errgroup.Group)
+	g.Go(r.RunWithCtxField)
+	_ = g.Wait()
+}
+
+// [BAD]: Type parameter method value - sole implementor has no ctx
+//
+// The constraint has a single concrete implementor whose method ignores context.
+func badTypeParamMethodValueNoCtx[R ctxlessRunner](ctx context.Context, r R) {
+	g := new(errgroup.Group)
+	g.Go(r.RunWithoutCtxField) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [LIMITATION]: Type parameter method value - several implementors
+//
+// The concrete type can't be determined, so the method value is assumed OK.
+func limitationTypeParamMethodValueAmbiguous[R ambiguousRunner](ctx context.Context, r R) {
+	g := new(errgroup.Group)
+	g.Go(r.RunAmbiguous)
+	_ = g.Wait()
+}
+
+// ===== STRUCT-RETURNING FACTORY PATTERNS =====
+
+type job struct {
+	name string
+	run  func() error
+}
+
+//vt:helper
+func newJob() job {
+	return job{name: "job", run: func() error {
+		fmt.Println("no ctx")
+		return nil
+	}}
+}
+
+//vt:helper
+func newJobWithCtx(ctx context.Context) job {
+	return job{name: "job", run: func() error {
+		_ = ctx
+		return nil
+	}}
+}
+
+//vt:helper
+func newJobPtr() *job {
+	return &job{name: "job", run: func() error {
+		fmt.Println("no ctx")
+		return nil
+	}}
+}
+
+//vt:helper
+func newJobFromHolder() job {
+	j := job{name: "job"}
+	return j
+}
+
+// [BAD]: Struct-returning factory - field func without ctx
+//
+// The field func of the returned composite literal does not use context.
+func badStructFactoryFieldWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(newJob().run) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [GOOD]: Struct-returning factory - called with ctx
+//
+// Factory receives context, so the field func can use it.
+func goodStructFactoryFieldWithCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(newJobWithCtx(ctx).run)
+	_ = g.Wait()
+}
+
+// [GOOD]: Struct-returning local factory - field func captures ctx
+//
+// Local factory returns a composite literal whose field func captures ctx.
+func goodStructLocalFactoryFieldCapturesCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	build := func() job {
+		return job{run: func() error {
+			_ = ctx
+			return nil
+		}}
+	}
+	g.Go(build().run)
+	_ = g.Wait()
+}
+
+// [BAD]: Struct-returning local factory - field func without ctx
+//
+// Local factory returns a pointer composite literal whose field func ignores ctx.
+func badStructLocalFactoryPointerFieldWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	build := func() *job {
+		return &job{run: func() error {
+			fmt.Println("no ctx")
+			return nil
+		}}
+	}
+	g.Go(build().run) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [LIMITATION]: Struct-returning factory - returned variable
+//
+// The factory returns a variable instead of a composite literal, so the field is assumed OK.
+func limitationStructFactoryReturnsVariable(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(newJobFromHolder().run)
+	_ = g.Wait()
+}
+
+// [BAD]: Struct-returning factory assigned to variable - field func without ctx
+//
+// The variable is initialized from an in-package factory whose field func ignores ctx.
+func badStructFactoryVarFieldWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	j := newJob()
+	g.Go(j.run) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [GOOD]: Struct-returning factory assigned to variable - called with ctx
+//
+// The factory receives context, so the field func can use it.
+func goodStructFactoryVarFieldWithCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	j := newJobWithCtx(ctx)
+	g.Go(j.run)
+	_ = g.Wait()
+}
+
+// [GOOD]: Struct-returning factory reassigned after Go
+//
+// Only the factory assigned before g.Go decides the field func.
+func goodStructFactoryVarReassignedAfterGo(ctx context.Context) {
+	g := new(errgroup.Group)
+	j := newJobWithCtx(ctx)
+	g.Go(j.run)
+	j = newJob()
+	_ = j
+	_ = g.Wait()
+}
+
+// [BAD]: Struct-returning factory with ctx assigned only after Go
+//
+// A context-using factory assigned after g.Go does not fix the field func passed earlier.
+func badStructFactoryVarWithCtxAssignedAfterGo(ctx context.Context) {
+	g := new(errgroup.Group)
+	j := newJob()
+	g.Go(j.run) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	j = newJobWithCtx(ctx)
+	_ = j
+	_ = g.Wait()
+}
+
+// [BAD]: Pointer-returning factory assigned to variable - field func without ctx
+//
+// The factory returns the address of a composite literal whose field func ignores ctx.
+func badPointerFactoryVarFieldWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	j := newJobPtr()
+	g.Go(j.run) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// ===== NAMED FUNC TYPE PATTERNS =====
+
+type namedTask func() error
+
+// [BAD]: Named func type variable without ctx
+//
+// The literal is assigned to a variable declared with a defined func type.
+func badNamedFuncTypeVarWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	var t namedTask = func() error {
+		fmt.Println("no ctx")
+		return nil
+	}
+	g.Go(t) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [GOOD]: Named func type variable with ctx
+//
+// The literal assigned to the defined func type variable captures ctx.
+func goodNamedFuncTypeVarWithCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	var t namedTask = func() error {
+		return ctx.Err()
+	}
+	g.Go(t)
+	_ = g.Wait()
+}
+
+// [BAD]: Named func type conversion without ctx
+//
+// The literal is converted to the defined func type before assignment.
+func badNamedFuncTypeConversionWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	t := namedTask(func() error {
+		fmt.Println("no ctx")
+		return nil
+	})
+	g.Go(t) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [GOOD]: Named func type conversion with ctx
+//
+// The converted literal captures ctx.
+func goodNamedFuncTypeConversionWithCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	t := namedTask(func() error {
+		return ctx.Err()
+	})
+	g.Go(t)
+	_ = g.Wait()
+}
+
+// [BAD]: Named func type reassigned without ctx
+//
+// The last assignment to the defined func type variable drops ctx.
+func badNamedFuncTypeReassignedWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	var t namedTask
+	t = func() error {
+		fmt.Println("no ctx")
+		return nil
+	}
+	g.Go(t) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// ===== PACKAGE FUNCTION REFERENCES =====
+
+//vt:helper
+func backgroundWork() error {
+	fmt.Println("no ctx")
+	return nil
+}
+
+//vt:helper
+func backgroundWorkWithCtx(ctx context.Context) func() error {
+	return func() error {
+		return ctx.Err()
+	}
+}
+
+// [BAD]: Package-level function passed by name
+//
+// A package-level function cannot capture the caller's context.
+func badPackageFuncReference(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(backgroundWork) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [GOOD]: Package-level factory called with ctx
+//
+// The factory call receives ctx, so the returned closure can use it.
+func goodPackageFuncFactoryWithCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(backgroundWorkWithCtx(ctx))
+	_ = g.Wait()
+}
+
+// ===== RANGED FUNC CONTAINERS =====
+// Ranging over a slice or map of func literals checks every element.
+
+// [GOOD]: Ranged map where every func uses ctx
+//
+// Every handler in the map captures context, so whichever is spawned is fine.
+func goodRangedMapAllCapture(ctx context.Context) {
+	g := new(errgroup.Group)
+	handlers := map[string]func() error{
+		"a": func() error { return ctx.Err() },
+		"b": func() error {
+			_ = ctx
+			return nil
+		},
+	}
+	for _, fn := range handlers {
+		g.Go(fn)
+	}
+	_ = g.Wait()
+}
+
+// [BAD]: Ranged map where one func drops ctx
+//
+// One handler in the map doesn't capture context, and ranging spawns all of them.
+func badRangedMapOneDrops(ctx context.Context) {
+	g := new(errgroup.Group)
+	handlers := map[string]func() error{
+		"a": func() error { return ctx.Err() },
+		"b": func() error { return nil },
+	}
+	for _, fn := range handlers {
+		g.Go(fn) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	}
+	_ = g.Wait()
+}
+
+// [GOOD]: Ranged slice where every func uses ctx
+//
+// Every element of the slice captures context.
+func goodRangedSliceAllCapture(ctx context.Context) {
+	g := new(errgroup.Group)
+	var tasks = []func() error{
+		func() error { return ctx.Err() },
+		func() error { return ctx.Err() },
+	}
+	for _, task := range tasks {
+		g.Go(task)
+	}
+	_ = g.Wait()
+}
+
+// [BAD]: Ranged slice literal where one func drops ctx
+//
+// The container is ranged over directly; the second element doesn't capture context.
+func badRangedSliceLiteralOneDrops(ctx context.Context) {
+	g := new(errgroup.Group)
+	for _, task := range []func() error{
+		func() error { return ctx.Err() },
+		func() error { return nil },
+	} {
+		g.Go(task) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	}
+	_ = g.Wait()
+}
+
+// [GOOD]: Ranged slice with untraceable elements
+//
+// Elements that aren't func literals can't be analyzed and are assumed OK.
+func goodRangedSliceUntraceable(ctx context.Context) {
+	g := new(errgroup.Group)
+	tasks := []func() error{
+		backgroundWorkWithCtx(ctx),
+		func() error { return ctx.Err() },
+	}
+	for _, task := range tasks {
+		g.Go(task)
+	}
+	_ = g.Wait()
+}
+
+// ===== GENERIC CONTEXT FACTORY PATTERNS =====
+
+// makeCtxTask returns a closure using the context typed by a type parameter.
+//
+//vt:helper
+func makeCtxTask[C context.Context](c C) func() error {
+	return func() error {
+		return c.Err()
+	}
+}
+
+// makeIgnoringTask returns a closure ignoring the context it was given.
+//
+//vt:helper
+func makeIgnoringTask[C context.Context](c C) func() error {
+	return func() error {
+		return nil
+	}
+}
+
+// ctxConstraint embeds context.Context.
+type ctxConstraint interface {
+	context.Context
+}
+
+// makeEmbeddedCtxTask returns a closure using a context typed by a type
+// parameter whose constraint embeds context.Context.
+//
+//vt:helper
+func makeEmbeddedCtxTask[C ctxConstraint](c C) func() error {
+	return func() error {
+		return c.Err()
+	}
+}
+
+// [GOOD]: Generic factory capturing context type parameter
+//
+// The type parameter is constrained to context.Context and the returned closure captures it.
+func goodGenericCtxFactory(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(makeCtxTask(ctx))
+	_ = g.Wait()
+}
+
+// [GOOD]: Generic factory with embedding constraint
+//
+// The constraint embeds context.Context, so the type parameter is a context too.
+func goodGenericEmbeddedCtxFactory(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(makeEmbeddedCtxTask(ctx))
+	_ = g.Wait()
+}
+
+// [LIMITATION]: Generic factory ignoring context type parameter
+//
+// Passing ctx to a factory counts as propagation, so the returned closure ignoring it is not reported.
+func limitationGenericIgnoringFactory(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(makeIgnoringTask(ctx))
+	_ = g.Wait()
+}
+
+// [GOOD]: Go statement in generic function
+//
+// The type parameter typed context is in scope and used by the goroutine.
+func goodGoroutineInGenericFunc[C context.Context](c C) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		return c.Err()
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Closure ignoring context type parameter
+//
+// The type parameter typed context is in scope but the closure ignores it.
+func badClosureInGenericFunc[C context.Context](c C) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "c"`
+		return nil
+	})
+	_ = g.Wait()
+}
@@ -0,0 +1,133 @@
+// Package escapinggoroutine tests the escapinggoroutine checker.
+package escapinggoroutine
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sourcegraph/conc/pool"
+	"golang.org/x/sync/errgroup"
+)
+
+//vt:helper
+func leak() {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Bare goroutine inside errgroup closure
+//
+// The group waits for the closure, not for the goroutine it starts.
+func badErrgroupBareGoroutine() error {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		go leak() // want `bare goroutine inside a managed group escapes the group`
+		return nil
+	})
+	return g.Wait()
+}
+
+// [BAD]: Bare goroutine inside errgroup closure with context
+//
+// A context in scope does not make the goroutine tracked.
+func badErrgroupWithContextBareGoroutine(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		go func() { // want `bare goroutine inside a managed group escapes the group`
+			<-ctx.Done()
+		}()
+		return nil
+	})
+	return g.Wait()
+}
+
+// [BAD]: Bare goroutine inside conc pool closure
+//
+// conc pools only wait for the tasks they run.
+func badConcPoolBareGoroutine() {
+	p := pool.New()
+	p.Go(func() {
+		go leak() // want `bare goroutine inside a managed group escapes the group`
+	})
+	p.Wait()
+}
+
+// [BAD]: Bare goroutine in immediately invoked func inside closure
+//
+// The func literal runs as part of the closure.
+func badImmediatelyInvokedBareGoroutine() error {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		func() {
+			go leak() // want `bare goroutine inside a managed group escapes the group`
+		}()
+		return nil
+	})
+	return g.Wait()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Goroutine joined with a WaitGroup inside the closure
+//
+// The closure waits for its own goroutine before returning.
+func goodJoinedInsideClosure() error {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+		}()
+		wg.Wait()
+		return nil
+	})
+	return g.Wait()
+}
+
+// [GOOD]: Nested group inside closure
+//
+// Work spawned through a nested group is tracked by it.
+func goodNestedGroup() error {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		inner := new(errgroup.Group)
+		inner.Go(func() error { return nil })
+		return inner.Wait()
+	})
+	return g.Wait()
+}
+
+// [GOOD]: Goroutine outside any group
+//
+// Plain goroutines are left to the other checkers.
+func goodBareGoroutineOutsideGroup() {
+	go leak()
+}
+
+// [GOOD]: Goroutine in func literal stored inside closure
+//
+// The stored func may run anywhere, so it is not attributed to the group.
+func goodStoredFuncLit() (func(), error) {
+	var later func()
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		later = func() {
+			go leak()
+		}
+		return nil
+	})
+	return later, g.Wait()
+}
+
+// [GOOD]: Bare goroutine with ignore directive
+//
+// The goroutine is meant to outlive the group.
+func goodIgnoredBareGoroutine() error {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		//goroutinectx:ignore escapinggoroutine - fire-and-forget metrics flush
+		go leak()
+		return nil
+	})
+	return g.Wait()
+}
@@ -0,0 +1,16 @@
+//go:build go1.25
+
+package escapinggoroutine
+
+import "sync"
+
+// [BAD]: Bare goroutine inside sync.WaitGroup.Go closure
+//
+// sync.WaitGroup.Go has the same problem.
+func badWaitgroupBareGoroutine() {
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		go leak() // want `bare goroutine inside a managed group escapes the group`
+	})
+	wg.Wait()
+}
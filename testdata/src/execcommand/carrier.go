@@ -0,0 +1,16 @@
+package execcommand
+
+import (
+	"os/exec"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ===== SHOULD REPORT WITHOUT FIX =====
+
+// [BAD]: exec.Command with carrier
+//
+// A carrier is not a context.Context, so no fix is suggested.
+func badExecCommandCarrier(c echo.Context) error {
+	return exec.Command("sleep", "10").Run() // want `use exec.CommandContext with context "c" instead of exec.Command`
+}
@@ -0,0 +1,68 @@
+// Package execcommand tests the execcommand checker.
+package execcommand
+
+import (
+	"context"
+	"os/exec"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: exec.Command with ctx in scope
+//
+// The process keeps running after the context is canceled.
+func badExecCommand(ctx context.Context) error {
+	return exec.Command("sleep", "10").Run() // want `use exec.CommandContext with context "ctx" instead of exec.Command`
+}
+
+// [BAD]: exec.Command inside goroutine
+//
+// The goroutine closure inherits the enclosing function's context scope.
+func badExecCommandInGoroutine(ctx context.Context) {
+	go func() {
+		_ = exec.Command("sleep", "10").Run() // want `use exec.CommandContext with context "ctx" instead of exec.Command`
+		_ = ctx
+	}()
+}
+
+// [BAD]: exec.Command inside goroutine without ctx
+//
+// Both the goroutine and the exec checkers report the closure.
+func badExecCommandInGoroutineNoCtx(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		_ = exec.Command("sleep", "10").Run() // want `use exec.CommandContext with context "ctx" instead of exec.Command`
+	}()
+}
+
+// [BAD]: exec.Command in nested closure
+//
+// Scope is inherited through nested function literals.
+func badExecCommandNestedClosure(reqCtx context.Context) {
+	run := func() error {
+		return exec.Command("true").Run() // want `use exec.CommandContext with context "reqCtx" instead of exec.Command`
+	}
+	_ = run()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: exec.CommandContext
+//
+// The process is killed when the context is done.
+func goodExecCommandContext(ctx context.Context) error {
+	return exec.CommandContext(ctx, "sleep", "10").Run()
+}
+
+// [GOOD]: exec.Command without ctx in scope
+//
+// Nothing to propagate.
+func goodExecCommandNoCtx() error {
+	return exec.Command("true").Run()
+}
+
+// [GOOD]: exec.Command with ignore directive
+//
+// The ignore directive suppresses the report.
+func goodExecCommandIgnored(ctx context.Context) error {
+	return exec.Command("true").Run() //goroutinectx:ignore execcommand
+}
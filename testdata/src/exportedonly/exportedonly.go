@@ -0,0 +1,90 @@
+// Package exportedonly contains test fixtures for the -exported-only flag.
+package exportedonly
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Exported function spawning goroutine without ctx
+//
+// Exported functions are checked.
+func BadExportedGoroutine(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+	}()
+}
+
+// [BAD]: Nested closure in exported function
+//
+// Closures nested in exported functions are checked, including their own ctx parameters.
+func BadExportedNestedClosure() {
+	run := func(ctx context.Context) {
+		g := new(errgroup.Group)
+		g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+			return nil
+		})
+		_ = g.Wait()
+	}
+	run(context.Background())
+}
+
+// Service is an exported type.
+type Service struct{}
+
+// [BAD]: Exported method on exported type
+//
+// Methods that are part of the package API are checked.
+func (s *Service) BadExportedMethod(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Unexported function spawning goroutine without ctx
+//
+// Unexported helpers are skipped entirely.
+func goodUnexportedGoroutine(ctx context.Context) {
+	go func() {
+	}()
+}
+
+// [GOOD]: Nested closure in unexported function
+//
+// Closures nested in unexported functions are skipped as well.
+func goodUnexportedNestedClosure() {
+	run := func(ctx context.Context) {
+		go func() {
+		}()
+	}
+	run(context.Background())
+}
+
+// service is an unexported type.
+type service struct{}
+
+// [GOOD]: Exported method on unexported type
+//
+// Methods of unexported types are not part of the package API.
+func (s *service) GoodUnexportedTypeMethod(ctx context.Context) {
+	go func() {
+	}()
+}
+
+// [GOOD]: Exported function propagating ctx
+//
+// Exported functions propagating ctx are not reported.
+func GoodExportedGoroutine(ctx context.Context) {
+	go func() {
+		_ = ctx
+	}()
+}
+
+// Package-level func literals are outside any top-level function.
+var packageLevel = func(ctx context.Context) {
+	go func() {
+	}()
+}
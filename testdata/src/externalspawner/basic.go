@@ -4,6 +4,7 @@ package externalspawner
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/example/workerpool"
 )
@@ -29,6 +30,16 @@ func badRun(ctx context.Context) {
 	})
 }
 
+// [BAD]: time.AfterFunc without ctx
+//
+// Standard library functions can be registered as external spawners
+func badAfterFunc(ctx context.Context) {
+	t := time.AfterFunc(time.Second, func() { // want `AfterFunc\(\) func argument should use context "ctx"`
+		fmt.Println("no ctx")
+	})
+	defer t.Stop()
+}
+
 // ===== SHOULD NOT REPORT =====
 
 // [GOOD]: Pool.Submit with ctx
@@ -50,6 +61,19 @@ func goodRun(ctx context.Context) {
 	})
 }
 
+// [GOOD]: time.AfterFunc with ctx
+//
+// Registered standard library spawner with ctx
+func goodAfterFunc(ctx context.Context) {
+	t := time.AfterFunc(time.Second, func() {
+		if ctx.Err() != nil {
+			return
+		}
+		fmt.Println("fired")
+	})
+	defer t.Stop()
+}
+
 // [GOOD]: No ctx param
 //
 // No context parameter - not checked
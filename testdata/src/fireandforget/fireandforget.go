@@ -0,0 +1,106 @@
+// Package fireandforget tests goroutines calling only -fire-and-forget-funcs.
+package fireandforget
+
+import (
+	"context"
+	"fmt"
+)
+
+//vt:helper
+func reportError(err error) {}
+
+//vt:helper
+func doWork() {}
+
+//vt:helper
+func doWorkErr() error { return nil }
+
+// Metrics is a fire-and-forget metrics client.
+type Metrics struct{}
+
+//vt:helper
+func (*Metrics) Inc(name string) {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Goroutine doing more than fire-and-forget calls
+//
+// Other work in the goroutine still needs the context.
+func badFireAndForgetWithWork(ctx context.Context, err error) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		reportError(err)
+		doWork()
+	}()
+}
+
+// [BAD]: Goroutine calling an unlisted function
+//
+// Only the configured functions are exempt.
+func badFireAndForgetUnlisted(ctx context.Context, err error) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		fmt.Println(err)
+	}()
+}
+
+// [BAD]: Goroutine with control flow around a listed call
+//
+// The body must consist of listed calls only.
+func badFireAndForgetConditional(ctx context.Context, err error) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		if err != nil {
+			reportError(err)
+		}
+	}()
+}
+
+// [BAD]: Listed call with an unlisted call in its arguments
+//
+// The argument runs unlisted work in the goroutine.
+func badFireAndForgetNestedCall(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		reportError(doWorkErr())
+	}()
+}
+
+// [BAD]: Listed call with a func literal in its arguments
+//
+// An immediately invoked func literal runs unlisted work.
+func badFireAndForgetNestedFuncLit(ctx context.Context, m *Metrics) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		m.Inc(func() string {
+			doWork()
+			return "errors"
+		}())
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Goroutine calling only a listed function
+//
+// Error reporting is legitimately fire-and-forget.
+func goodFireAndForgetReportError(ctx context.Context, err error) {
+	go func() {
+		reportError(err)
+	}()
+}
+
+// [GOOD]: Goroutine calling only listed functions and methods
+//
+// Several listed calls are exempt together.
+func goodFireAndForgetMultiple(ctx context.Context, m *Metrics, err error) {
+	go func() {
+		m.Inc("errors")
+		reportError(err)
+	}()
+}
+
+// [GOOD]: Listed calls with listed calls and conversions as arguments
+//
+// Arguments built only from listed calls and conversions run no other work.
+func goodFireAndForgetNestedListed(ctx context.Context, m *Metrics, err error) {
+	go func() {
+		m.Inc(string([]byte("errors")))
+		reportError(error(err))
+	}()
+}
@@ -0,0 +1,69 @@
+// Package backoff provides stub types for cenkalti/backoff/v4.
+package backoff
+
+import (
+	"context"
+	"time"
+)
+
+// BackOff is a backoff policy for retrying an operation.
+type BackOff interface {
+	NextBackOff() time.Duration
+	Reset()
+}
+
+// BackOffContext is a backoff policy that stops when its context is done.
+type BackOffContext interface {
+	BackOff
+	Context() context.Context
+}
+
+// Operation is the function retried by Retry.
+type Operation func() error
+
+// Notify is called on each failed attempt.
+type Notify func(error, time.Duration)
+
+// ExponentialBackOff increases the back off period exponentially.
+type ExponentialBackOff struct {
+	InitialInterval time.Duration
+	MaxElapsedTime  time.Duration
+}
+
+// NewExponentialBackOff creates an ExponentialBackOff with default settings.
+func NewExponentialBackOff() *ExponentialBackOff { return &ExponentialBackOff{} }
+
+func (b *ExponentialBackOff) NextBackOff() time.Duration { return 0 }
+func (b *ExponentialBackOff) Reset()                     {}
+
+// ConstantBackOff always returns the same back off period.
+type ConstantBackOff struct {
+	Interval time.Duration
+}
+
+// NewConstantBackOff creates a ConstantBackOff.
+func NewConstantBackOff(d time.Duration) *ConstantBackOff { return &ConstantBackOff{Interval: d} }
+
+func (b *ConstantBackOff) NextBackOff() time.Duration { return b.Interval }
+func (b *ConstantBackOff) Reset()                     {}
+
+type backOffContext struct {
+	BackOff
+	ctx context.Context
+}
+
+func (b *backOffContext) Context() context.Context { return b.ctx }
+
+// WithContext returns a policy that stops when ctx is done.
+func WithContext(b BackOff, ctx context.Context) BackOffContext {
+	return &backOffContext{BackOff: b, ctx: ctx}
+}
+
+// WithMaxRetries returns a policy that stops after max retries.
+func WithMaxRetries(b BackOff, max uint64) BackOff { return b }
+
+// Retry retries the operation until it succeeds or the policy stops.
+func Retry(o Operation, b BackOff) error { return o() }
+
+// RetryNotify is Retry calling notify on each failed attempt.
+func RetryNotify(o Operation, b BackOff, notify Notify) error { return o() }
@@ -0,0 +1,37 @@
+// Package asyncjob is a fictional async job library launching jobs in
+// goroutines, configured through -async-launchers.
+package asyncjob
+
+import "context"
+
+// Job is a job under construction.
+type Job struct {
+	ctx context.Context
+	fn  func(ctx context.Context) error
+}
+
+// New creates a job running fn.
+func New(fn func(ctx context.Context) error) *Job {
+	return &Job{fn: fn}
+}
+
+// WithContext sets the context the job runs with.
+func (j *Job) WithContext(ctx context.Context) *Job {
+	j.ctx = ctx
+	return j
+}
+
+// Retry sets the number of retries.
+func (j *Job) Retry(n int) *Job {
+	return j
+}
+
+// Start runs the job in a new goroutine with the context set by WithContext.
+func (j *Job) Start() {
+	go func() { _ = j.fn(j.ctx) }()
+}
+
+// Run runs the named job in a new goroutine with ctx.
+func (j *Job) Run(name string, ctx context.Context) {
+	go func() { _ = j.fn(ctx) }()
+}
@@ -0,0 +1,33 @@
+// Package jobqueue is a fictional job queue library configured only through
+// a library spec bundle.
+package jobqueue
+
+import "context"
+
+// Queue runs jobs.
+type Queue struct{}
+
+// Spawn runs fn in a new goroutine.
+func (q *Queue) Spawn(fn func()) {
+	go fn()
+}
+
+// Job carries the context of a running job.
+type Job interface {
+	Context() context.Context
+	Name() string
+}
+
+// WithJob derives the context a job goroutine should use.
+func WithJob(ctx context.Context) context.Context {
+	return ctx
+}
+
+// Enqueue schedules the named job, propagating the request values of ctx.
+func Enqueue(ctx context.Context, name string) {}
+
+// Wait blocks until all jobs have finished.
+func (q *Queue) Wait() {}
+
+// OnIdle registers fn to run whenever the queue becomes idle.
+func (q *Queue) OnIdle(fn func()) {}
@@ -9,6 +9,7 @@ type Context interface {
 	Get(key string) any
 	Set(key string, val any)
 	RealContext() context.Context
+	Logger() Logger
 	// Real echo.Context has many more methods...
 }
 
@@ -20,6 +21,12 @@ func (c *echoContext) Response() any                { return nil }
 func (c *echoContext) Get(key string) any           { return nil }
 func (c *echoContext) Set(key string, val any)      {}
 func (c *echoContext) RealContext() context.Context { return context.Background() }
+func (c *echoContext) Logger() Logger               { return nil }
+
+// Logger is the logger bound to a request.
+type Logger interface {
+	Info(i ...any)
+}
 
 // New creates a new echo context (stub).
 func New() Context {
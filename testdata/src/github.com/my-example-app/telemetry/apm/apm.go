@@ -24,3 +24,19 @@ func StartSpan(ctx context.Context, name string) context.Context {
 	// In real implementation, this would create a span
 	return ctx
 }
+
+// StartSegment starts a segment and returns a function ending it.
+func StartSegment(ctx context.Context, name string) (context.Context, func()) {
+	return ctx, func() {}
+}
+
+// Annotate attaches an attribute to the current transaction.
+func Annotate(ctx context.Context, key, value string) {}
+
+// Tracer wraps APM tracing for a component.
+type Tracer struct{}
+
+// Wrap returns the context unchanged.
+func (*Tracer) Wrap(ctx context.Context) context.Context {
+	return ctx
+}
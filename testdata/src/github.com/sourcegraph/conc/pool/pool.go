@@ -6,6 +6,12 @@ import "context"
 // Pool is a stub for pool.Pool.
 type Pool struct{}
 
+// New creates a new Pool.
+func New() *Pool { return &Pool{} }
+
+// WithContext converts the pool to a ContextPool bound to ctx.
+func (*Pool) WithContext(ctx context.Context) *ContextPool { return &ContextPool{} }
+
 // Go submits a task to the pool.
 func (*Pool) Go(f func()) {}
 
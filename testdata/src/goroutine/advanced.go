@@ -8,6 +8,9 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
 )
 
 // ===== DEFER PATTERNS =====
@@ -65,6 +68,57 @@ func goodGoroutineUsesCtxInRecoveryClosure(ctx context.Context) {
 	}()
 }
 
+// [GOOD]: Deferred goroutine from factory with ctx
+//
+// Cleanup code spawns a goroutine from a factory that receives ctx.
+func goodDeferredGoFactoryWithCtx(ctx context.Context) {
+	defer func() {
+		go startBackground(ctx)()
+	}()
+}
+
+// [BAD]: Deferred goroutine from factory without ctx
+//
+// Deferring the spawn does not exempt a factory that drops ctx.
+func badDeferredGoFactoryWithoutCtx(ctx context.Context) {
+	defer func() {
+		go startBackgroundDetached()() // want `goroutine does not propagate context "ctx"`
+	}()
+}
+
+// [GOOD]: Deferred goroutine calling function with ctx
+//
+// The spawned function receives ctx directly as an argument.
+func goodDeferredGoCallWithCtx(ctx context.Context) {
+	defer func() {
+		go doSomething(ctx)
+	}()
+}
+
+// [GOOD]: Deferred goroutine from factory with ctx in outer defer
+//
+// The factory call is evaluated when the outer defer runs, with ctx as argument.
+func goodDeferredFactoryCallWithCtx(ctx context.Context) {
+	defer func() {
+		spawn := startBackground(ctx)
+		go spawn()
+	}()
+}
+
+//vt:helper
+func startBackground(ctx context.Context) func() {
+	return func() {
+		<-ctx.Done()
+	}
+}
+
+//vt:helper
+func startBackgroundDetached() func() {
+	return func() {
+		fmt.Println("cleanup")
+	}
+}
+
 // ===== GOROUTINE IN LOOP =====
 
 // [BAD]: Go in for loop without ctx
@@ -201,6 +255,114 @@ func goodGoroutineReturnsWithCtx(ctx context.Context) {
 //vt:helper
 func compute() int { return 42 }
 
+// [GOOD]: Context received from captured channel
+//
+// Goroutine receives its context from a captured channel of contexts.
+func goodGoroutineReceivesCtxFromChannel(ctx context.Context) {
+	ch := make(chan context.Context, 1)
+	go func() {
+		c := <-ch
+		doSomething(c)
+	}()
+	ch <- ctx
+}
+
+// [GOOD]: Context received from captured channel - select case
+//
+// Goroutine receives its context from a captured channel inside a select.
+func goodGoroutineReceivesCtxFromChannelInSelect(ctx context.Context) {
+	ch := make(chan context.Context, 1)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case c := <-ch:
+			doSomething(c)
+		case <-done:
+		}
+	}()
+	ch <- ctx
+}
+
+// [BAD]: Context received from captured channel
+//
+// Goroutine receives from a captured channel whose element is not a context.
+func badGoroutineReceivesFromNonCtxChannel(ctx context.Context) {
+	ch := make(chan int, 1)
+	go func() { // want `goroutine does not propagate context "ctx"`
+		v := <-ch
+		_ = v
+	}()
+	ch <- 1
+}
+
+// [GOOD]: Consumer running funcs from a channel of ctx-capturing literals
+//
+// Goroutine runs jobs received from a local channel that is only fed func literals using ctx.
+func goodGoroutineRunsCtxFuncsFromChannel(ctx context.Context) {
+	jobs := make(chan func())
+	go func() {
+		for job := range jobs {
+			job()
+		}
+	}()
+	for i := 0; i < 3; i++ {
+		jobs <- func() { doSomething(ctx) }
+	}
+	close(jobs)
+}
+
+// [GOOD]: Consumer receiving a func fed before the spawn
+//
+// The channel is filled with a ctx-capturing literal before the goroutine starts.
+func goodGoroutineRunsCtxFuncReceivedFromChannel(ctx context.Context) {
+	jobs := make(chan func(), 1)
+	jobs <- func() { doSomething(ctx) }
+	go func() {
+		job := <-jobs
+		job()
+	}()
+}
+
+// [BAD]: Consumer running funcs from a channel fed a literal without ctx
+//
+// One of the funcs sent on the channel does not use ctx.
+func badGoroutineRunsFuncsFromChannelWithoutCtx(ctx context.Context) {
+	jobs := make(chan func(), 2)
+	jobs <- func() { doSomething(ctx) }
+	jobs <- func() { fmt.Println("no ctx") }
+	go func() { // want `goroutine does not propagate context "ctx"`
+		for job := range jobs {
+			job()
+		}
+	}()
+}
+
+// [BAD]: Consumer running funcs from a channel that escapes
+//
+// The channel is passed to another function, which may feed it anything.
+func badGoroutineRunsFuncsFromEscapingChannel(ctx context.Context) {
+	jobs := make(chan func(), 1)
+	jobs <- func() { doSomething(ctx) }
+	feedJobs(jobs)
+	go func() { // want `goroutine does not propagate context "ctx"`
+		(<-jobs)()
+	}()
+}
+
+//vt:helper
+func feedJobs(jobs chan<- func()) {}
+
+// [BAD]: Context channel captured but never received
+//
+// Goroutine only sends to a captured channel of contexts, so no context flows in.
+func badGoroutineSendsToCtxChannel(ctx context.Context) {
+	ch := make(chan context.Context, 1)
+	go func() { // want `goroutine does not propagate context "ctx"`
+		ch <- context.Background()
+	}()
+	<-ch
+}
+
 // ===== SELECT PATTERNS =====
 
 // [BAD]: Select statement
@@ -234,6 +396,68 @@ func goodGoroutineWithCtxInSelect(ctx context.Context) {
 	}()
 }
 
+// [BAD]: Goroutine spawned in select case
+//
+// Goroutine started from a select case body does not propagate context.
+func badGoroutineSpawnedInSelectCase(ctx context.Context) {
+	trigger := make(chan struct{})
+	select {
+	case <-trigger:
+		go func() { // want `goroutine does not propagate context "ctx"`
+			fmt.Println("triggered")
+		}()
+	case <-ctx.Done():
+		return
+	}
+}
+
+// [BAD]: Goroutine spawned in select default
+//
+// The default clause is checked like any other case body.
+func badGoroutineSpawnedInSelectDefault(ctx context.Context) {
+	trigger := make(chan struct{})
+	select {
+	case <-trigger:
+	default:
+		go func() { // want `goroutine does not propagate context "ctx"`
+			fmt.Println("fallback")
+		}()
+	}
+}
+
+// [GOOD]: Goroutine spawned in select case
+//
+// Goroutine started from a select case body captures context.
+func goodGoroutineSpawnedInSelectCase(ctx context.Context) {
+	trigger := make(chan struct{})
+	select {
+	case <-trigger:
+		go func() {
+			<-ctx.Done()
+		}()
+	case <-ctx.Done():
+		return
+	}
+}
+
+// [GOOD]: Goroutine spawned in select loop
+//
+// Goroutine started from a select inside a for loop captures context.
+func goodGoroutineSpawnedInSelectLoop(ctx context.Context) {
+	jobs := make(chan int)
+	for {
+		select {
+		case job := <-jobs:
+			go func() {
+				_ = ctx
+				fmt.Println(job)
+			}()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // ===== WAITGROUP PATTERN =====
 
 // [BAD]: Waitgroup pattern
@@ -304,6 +528,83 @@ func goodGoroutineCallsMethodWithCtx(ctx context.Context) {
 	}()
 }
 
+// ===== CONTEXT IN RECEIVER FIELD =====
+
+type ctxWorker struct {
+	ctx  context.Context
+	name string
+}
+
+//vt:helper
+func (w *ctxWorker) run() {
+	<-w.ctx.Done()
+	fmt.Println("stopped:", w.name)
+}
+
+//vt:helper
+func (w *ctxWorker) report() {
+	fmt.Println("running:", w.name)
+}
+
+// [GOOD]: Method using receiver ctx field
+//
+// Worker is initialized with ctx and its method uses w.ctx.
+func goodGoroutineCallsCtxFieldMethod(ctx context.Context) {
+	w := &ctxWorker{ctx: ctx, name: "test"}
+	go func() {
+		w.run()
+	}()
+}
+
+// [GOOD]: Method value spawned directly
+//
+// The go statement calls the method on a worker holding ctx.
+func goodGoroutineSpawnsCtxFieldMethod(ctx context.Context) {
+	w := &ctxWorker{ctx: ctx, name: "test"}
+	go w.run()
+}
+
+// [GOOD]: Receiver ctx field assigned after construction
+//
+// The ctx field is set by a plain assignment before the goroutine starts.
+func goodGoroutineCtxFieldAssigned(ctx context.Context) {
+	w := &ctxWorker{name: "test"}
+	w.ctx = ctx
+	go func() {
+		w.run()
+	}()
+}
+
+// [GOOD]: Value receiver struct with ctx field
+//
+// Address-free composite literals are traced the same way.
+func goodGoroutineCtxFieldValue(ctx context.Context) {
+	w := ctxWorker{ctx: ctx, name: "test"}
+	go func() {
+		w.run()
+	}()
+}
+
+// [BAD]: Method ignoring receiver ctx field
+//
+// Worker holds ctx but the called method never reads w.ctx.
+func badGoroutineCallsMethodIgnoringCtxField(ctx context.Context) {
+	w := &ctxWorker{ctx: ctx, name: "test"}
+	go func() { // want `goroutine does not propagate context "ctx"`
+		w.report()
+	}()
+}
+
+// [BAD]: Receiver ctx field not from scope
+//
+// Worker is initialized with context.Background() instead of ctx.
+func badGoroutineCtxFieldFromBackground(ctx context.Context) {
+	w := &ctxWorker{ctx: context.Background(), name: "test"}
+	go func() { // want `goroutine does not propagate context "ctx"`
+		w.run()
+	}()
+}
+
 // ===== MULTIPLE VARIABLE CAPTURE =====
 
 // [BAD]: Captures other vars but not ctx
@@ -329,6 +630,28 @@ func goodGoroutineCapturesCtxAmongOthers(ctx context.Context) {
 	}()
 }
 
+// [BAD]: Appends to captured slice but not ctx
+//
+// Closure appends other values to a captured slice without using context.
+func badGoroutineAppendsToCapturedSliceWithoutCtx(ctx context.Context) {
+	var results []any
+	go func() { // want `goroutine does not propagate context "ctx"`
+		results = append(results, "value")
+	}()
+	_ = results
+}
+
+// [GOOD]: Appends ctx to captured slice
+//
+// Storing ctx into a captured slice references it, so it counts as propagation.
+func goodGoroutineAppendsCtxToCapturedSlice(ctx context.Context) {
+	var results []any
+	go func() {
+		results = append(results, ctx)
+	}()
+	_ = results
+}
+
 // ===== CONTROL FLOW =====
 
 // [BAD]: Loop inside goroutine
@@ -466,3 +789,179 @@ func badStructFieldMissingCtx(ctx context.Context) {
 	}
 	go s.handler() // want `goroutine does not propagate context "ctx"`
 }
+
+// ===== CAPTURED DERIVED CONTEXT =====
+
+// [GOOD]: Captured errgroup gctx used only via Done
+//
+// The goroutine waits on the errgroup-derived context without using the parent ctx.
+func goodCapturedGctxDoneOnly(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx)
+	go func() {
+		select {
+		case <-gctx.Done():
+		}
+	}()
+	return g.Wait()
+}
+
+// [GOOD]: Captured errgroup gctx with multiple ctx params
+//
+// Using any in-scope context satisfies the check, even one derived from the second param.
+func goodCapturedGctxFromSecondParam(ctx, reqCtx context.Context) error {
+	g, gctx := errgroup.WithContext(reqCtx)
+	go func() {
+		<-gctx.Done()
+	}()
+	return g.Wait()
+}
+
+// [GOOD]: Captured WithCancel ctx used only via Done
+//
+// A sibling context derived with context.WithCancel also satisfies the check.
+func goodCapturedCancelCtxDoneOnly(ctx context.Context) {
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-cctx.Done()
+	}()
+}
+
+// [BAD]: Errgroup gctx derived but not captured
+//
+// Deriving gctx in the parent does not help a goroutine that uses neither context.
+func badGctxNotCaptured(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx)
+	_ = gctx
+	go func() { // want `goroutine does not propagate context "ctx"`
+		fmt.Println("no ctx")
+	}()
+	return g.Wait()
+}
+
+// ===== NESTED IN ERRGROUP CLOSURE =====
+
+//vt:helper
+func helper() {
+	fmt.Println("helper")
+}
+
+// [BAD]: Nested goroutine in errgroup closure
+//
+// The errgroup closure uses ctx, but the goroutine it spawns does not.
+func badNestedGoInErrgroupClosure(ctx context.Context) error {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		go func() { // want `goroutine does not propagate context "ctx"`
+			fmt.Println("no ctx")
+		}()
+		return ctx.Err()
+	})
+	return g.Wait()
+}
+
+// [BAD]: Nested goroutine in errgroup closure with gctx
+//
+// Scope is the enclosing function, so the derived gctx is not enough if unused.
+func badNestedGoInErrgroupClosureWithGctx(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		go func() { // want `goroutine does not propagate context "ctx"`
+			fmt.Println("no ctx")
+		}()
+		return gctx.Err()
+	})
+	return g.Wait()
+}
+
+// [GOOD]: Nested goroutine in errgroup closure
+//
+// Both the errgroup closure and the nested goroutine use ctx.
+func goodNestedGoInErrgroupClosure(ctx context.Context) error {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		go func() {
+			<-ctx.Done()
+		}()
+		return nil
+	})
+	return g.Wait()
+}
+
+// [GOOD]: Nested goroutine in errgroup closure with gctx
+//
+// The nested goroutine uses the errgroup-derived context.
+func goodNestedGoInErrgroupClosureWithGctx(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		go func() {
+			<-gctx.Done()
+		}()
+		return nil
+	})
+	return g.Wait()
+}
+
+// [LIMITATION]: Nested named function goroutine in errgroup closure
+//
+// go statements calling package-level functions are not analyzed.
+func limitationNestedNamedGoInErrgroupClosure(ctx context.Context) error {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		go helper() // No error - named functions are assumed OK
+		return ctx.Err()
+	})
+	return g.Wait()
+}
+
+// ===== TIMER-DRIVEN GOROUTINES =====
+
+// [BAD]: Goroutine waiting on time.NewTimer
+//
+// Waiting on a timer channel does not exempt the goroutine from propagating ctx.
+func badGoroutineWaitsOnTimer(ctx context.Context) {
+	timer := time.NewTimer(time.Second)
+	go func() { // want `goroutine does not propagate context "ctx"`
+		<-timer.C
+		fmt.Println("fired")
+	}()
+}
+
+// [BAD]: Goroutine driven by time.NewTicker
+//
+// The ticker loop never observes cancellation.
+func badGoroutineTickerLoop(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	go func() { // want `goroutine does not propagate context "ctx"`
+		defer ticker.Stop()
+		for range ticker.C {
+			fmt.Println("tick")
+		}
+	}()
+}
+
+// [GOOD]: Goroutine selecting on timer and ctx.Done()
+//
+// The timer goroutine stops early when ctx is canceled.
+func goodGoroutineTimerWithCtx(ctx context.Context) {
+	timer := time.NewTimer(time.Second)
+	go func() {
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+			fmt.Println("fired")
+		}
+	}()
+}
+
+// [LIMITATION]: time.AfterFunc callback
+//
+// time.AfterFunc runs its callback in a new goroutine, but it is not a known spawner.
+// Register it with -external-spawner=time.AfterFunc to check the callback.
+func limitationAfterFuncCallback(ctx context.Context) {
+	t := time.AfterFunc(time.Second, func() { // No error - not a known spawner
+		fmt.Println("fired")
+	})
+	defer t.Stop()
+}
@@ -161,6 +161,45 @@ func goodUsesCtxBeforeShadowing(ctx context.Context) {
 	}()
 }
 
+// [GOOD]: Self-shadow ctx := ctx
+//
+// Re-binding ctx with ctx := ctx uses the outer context on the right-hand side.
+// Later references resolve to the new local, which holds the same context.
+func goodSelfShadowCtx(ctx context.Context) {
+	go func() {
+		ctx := ctx
+		<-ctx.Done()
+	}()
+}
+
+// [GOOD]: Self-shadow ctx := ctx in nested block
+//
+// The self-shadow still counts as a use when it appears in a nested block.
+func goodSelfShadowCtxInNestedBlock(ctx context.Context) {
+	go func() {
+		if true {
+			ctx := ctx
+			_ = ctx.Err()
+		}
+	}()
+}
+
+// [GOOD]: Self-shadow ctx := ctx in loop
+//
+// Idiomatic per-iteration re-binding before spawning goroutines in a loop.
+func goodSelfShadowCtxInLoop(ctx context.Context) {
+	for i := 0; i < 3; i++ {
+		go func() {
+			ctx := ctx
+			select {
+			case <-ctx.Done():
+			default:
+				fmt.Println(i)
+			}
+		}()
+	}
+}
+
 // ===== MULTIPLE CONTEXT PARAMETERS =====
 
 // [BAD]: Multiple ctx params - reports first
@@ -712,6 +712,62 @@ func packageFactoryWithoutCtx() func() {
 	return func() { fmt.Println("no ctx") }
 }
 
+// [BAD]: Package-level multi-return factory without ctx
+//
+// The func is the second returned value and does not use context.
+func badPackageLevelMultiReturnFactoryWithoutCtx(ctx context.Context) {
+	name, fn := packageNamedFactoryWithoutCtx()
+	fmt.Println(name)
+	go fn() // want `goroutine does not propagate context "ctx"`
+}
+
+// [GOOD]: Package-level multi-return factory with ctx param
+//
+// The func is the second returned value and the factory receives context.
+func goodPackageLevelMultiReturnFactoryWithCtxParam(ctx context.Context) {
+	_, fn := packageNamedFactoryWithCtxParam(ctx)
+	go fn()
+}
+
+// [BAD]: Multi-return factory declared with var
+//
+// A var declaration from a factory is traced like a short variable declaration.
+func badMultiReturnFactoryVarDeclWithoutCtx(ctx context.Context) {
+	var name, fn = packageNamedFactoryWithoutCtx()
+	fmt.Println(name)
+	go fn() // want `goroutine does not propagate context "ctx"`
+}
+
+// [GOOD]: Multi-return factory reassigned after go
+//
+// Only the factory assigned before the go statement decides the result.
+func goodMultiReturnFactoryReassignedAfterGo(ctx context.Context) {
+	_, fn := packageNamedFactoryWithCtxParam(ctx)
+	go fn()
+	_, fn = packageNamedFactoryWithoutCtx()
+	fn()
+}
+
+// [BAD]: Multi-return factory with ctx assigned only after go
+//
+// A context-using factory assigned after the go statement does not fix the spawned func.
+func badMultiReturnFactoryWithCtxAssignedAfterGo(ctx context.Context) {
+	_, fn := packageNamedFactoryWithoutCtx()
+	go fn() // want `goroutine does not propagate context "ctx"`
+	_, fn = packageNamedFactoryWithCtxParam(ctx)
+	fn()
+}
+
+//vt:helper
+func packageNamedFactoryWithCtxParam(ctx context.Context) (string, func()) {
+	return "worker", func() { _ = ctx }
+}
+
+//vt:helper
+func packageNamedFactoryWithoutCtx() (string, func()) {
+	return "worker", func() { fmt.Println("no ctx") }
+}
+
 // ===== CONDITIONAL REASSIGNMENT PATTERNS =====
 // These test the analyzer's handling of variable reassignment in conditionals.
 // All paths must propagate context - if ANY assignment doesn't use ctx, warn.
@@ -755,3 +811,35 @@ func goodConditionalReassignAllUseCtx(ctx context.Context) {
 	}
 	go fn() // All paths safe
 }
+
+// ===== GENERIC CONTEXT FACTORY PATTERNS =====
+
+//vt:helper
+func genericCtxFactory[C context.Context](c C) func() {
+	return func() { _ = c }
+}
+
+// [GOOD]: Generic factory capturing context type parameter
+//
+// The type parameter is constrained to context.Context and the returned closure captures it.
+func goodGenericCtxFactory(ctx context.Context) {
+	go genericCtxFactory(ctx)()
+}
+
+// [GOOD]: Goroutine capturing context type parameter
+//
+// A parameter typed by a type parameter constrained to context.Context is a context in scope.
+func goodGoroutineCapturesTypeParamCtx[C context.Context](c C) {
+	go func() {
+		_ = c
+	}()
+}
+
+// [BAD]: Goroutine ignoring context type parameter
+//
+// The type parameter typed context is in scope but the goroutine ignores it.
+func badGoroutineIgnoresTypeParamCtx[C context.Context](c C) {
+	go func() { // want `goroutine does not propagate context "c"`
+		fmt.Println("no ctx")
+	}()
+}
@@ -86,6 +86,32 @@ func goodMixedOrAlternativeInNestedIIFE(ctx context.Context) {
 	}()
 }
 
+// [GOOD]: Mixed - OR alternative in doubly nested IIFE - SSA detects
+//
+// Deriver calls are collected through every level of immediately invoked closures.
+func goodMixedOrAlternativeInDoublyNestedIIFE(ctx context.Context) {
+	go func() { // SSA detects deriver call in inner IIFE
+		func() {
+			func() {
+				ctx = apm.NewGoroutineContext(ctx)
+				_ = ctx
+			}()
+		}()
+	}()
+}
+
+// [BAD]: Mixed - OR alternative in closure that is never invoked
+//
+// Only immediately invoked closures are traversed; a stored closure does not count.
+func badMixedOrAlternativeInUninvokedClosure(ctx context.Context) {
+	go func() { // want `goroutine should call github.com/newrelic/go-agent/v3/newrelic.Transaction.NewGoroutine\+github.com/newrelic/go-agent/v3/newrelic.NewContext,github.com/my-example-app/telemetry/apm.NewGoroutineContext to derive context`
+		derive := func() {
+			ctx = apm.NewGoroutineContext(ctx)
+		}
+		_ = derive
+	}()
+}
+
 // [BAD]: Mixed - nested 3-level, outer only has first of AND.
 //
 // Nested pattern where outer only calls first deriver of AND group.
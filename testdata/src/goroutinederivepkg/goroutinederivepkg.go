@@ -0,0 +1,72 @@
+package goroutinederivepkg
+
+import (
+	"context"
+
+	"github.com/my-example-app/telemetry/apm"
+)
+
+// Test cases for goroutine-derive checker with -deriver-packages=github.com/my-example-app/telemetry/apm
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Package wildcard - calls NewGoroutineContext.
+//
+// Any exported apm function returning context.Context is a deriver.
+func goodCallsNewGoroutineContext(ctx context.Context) {
+	go func() {
+		ctx := apm.NewGoroutineContext(ctx)
+		_ = ctx
+	}()
+}
+
+// [GOOD]: Package wildcard - calls StartSpan.
+//
+// A different function in the same package satisfies the check as well.
+func goodCallsStartSpan(ctx context.Context) {
+	go func() {
+		ctx := apm.StartSpan(ctx, "work")
+		_ = ctx
+	}()
+}
+
+// [GOOD]: Package wildcard - multi-value result.
+//
+// Functions returning context.Context among other results also qualify.
+func goodCallsStartSegment(ctx context.Context) {
+	go func() {
+		ctx, end := apm.StartSegment(ctx, "work")
+		defer end()
+		_ = ctx
+	}()
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Package wildcard - no deriver call.
+//
+// Goroutine uses ctx without calling any apm function.
+func badNoDeriver(ctx context.Context) {
+	go func() { // want `goroutine should call github.com/my-example-app/telemetry/apm\.\* to derive context`
+		_ = ctx
+	}()
+}
+
+// [BAD]: Package wildcard - function not returning context.
+//
+// Exported functions that do not return context.Context are not derivers.
+func badCallsNonContextFunc(ctx context.Context) {
+	go func() { // want `goroutine should call github.com/my-example-app/telemetry/apm\.\* to derive context`
+		apm.Annotate(ctx, "key", "value")
+	}()
+}
+
+// [BAD]: Package wildcard - method returning context.
+//
+// Only package-level functions match the wildcard, not methods.
+func badCallsMethod(ctx context.Context, t *apm.Tracer) {
+	go func() { // want `goroutine should call github.com/my-example-app/telemetry/apm\.\* to derive context`
+		ctx := t.Wrap(ctx)
+		_ = ctx
+	}()
+}
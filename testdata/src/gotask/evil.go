@@ -134,6 +134,58 @@ func limitationVariadicExpansionVariable(ctx context.Context) {
 	_ = gotask.DoAllFnsSettled(ctx, tasks...) // want `gotask\.DoAllFnsSettled\(\) variadic argument should call goroutine deriver`
 }
 
+// ===== VARIADIC EXPANSION FROM FUNCTION RESULT - SHOULD NOT REPORT =====
+
+//vt:helper
+func collectTasks(ctx context.Context) []func(context.Context) error {
+	_ = ctx
+	return nil
+}
+
+// [GOOD]: Slice returned by opaque call
+//
+// The slice is built by another function, so its contents are assumed OK.
+func goodVariadicExpansionFromCall(ctx context.Context) {
+	tasks := collectTasks(ctx)
+	_ = gotask.DoAllFnsSettled(ctx, tasks...)
+}
+
+// [GOOD]: Slice declared with opaque call
+//
+// A var declaration initialized by another function is traced like a short variable declaration.
+func goodVariadicExpansionFromCallVarDecl(ctx context.Context) {
+	var tasks = collectTasks(ctx)
+	_ = gotask.DoAllFnsSettled(ctx, tasks...)
+}
+
+// [GOOD]: Package-level slice from opaque call
+//
+// Package-level variables are initialized before use, even when declared further down the file.
+func goodVariadicExpansionFromCallPackageVar(ctx context.Context) {
+	_ = gotask.DoAllFnsSettled(ctx, packageTasks...)
+}
+
+// [BAD]: Slice assigned by opaque call only after use
+//
+// An opaque call assigning the slice after the gotask call does not make its earlier contents OK.
+func badVariadicExpansionCallAfterUse(ctx context.Context) {
+	tasks := []func(context.Context) error{
+		func(ctx context.Context) error { return nil },
+	}
+	_ = gotask.DoAllFnsSettled(ctx, tasks...) // want `gotask\.DoAllFnsSettled\(\) variadic argument should call goroutine deriver`
+	tasks = collectTasks(ctx)
+	_ = tasks
+}
+
+// [LIMITATION]: Slice of mixed origin
+//
+// An element appended locally does not call the deriver, but the slice also comes from an opaque call.
+func limitationVariadicExpansionMixedOrigin(ctx context.Context) {
+	tasks := collectTasks(ctx)
+	tasks = append(tasks, func(ctx context.Context) error { return nil })
+	_ = gotask.DoAllFnsSettled(ctx, tasks...) // No error - slice from opaque call is assumed OK
+}
+
 // ===== VARIABLE TASK - SHOULD NOT REPORT (variable tracing works) =====
 
 // [GOOD]: Variable func assignment with deriver is traced correctly
@@ -450,3 +502,5 @@ func limitationPointerDereferenceCallbackDeriver(ctx context.Context) {
 	// Can't trace taskPtr → &task → task → NewTask
 	(*taskPtr).DoAsync(ctx, nil) // want `gotask\.\(\*Task\)\.DoAsync\(\) 1st argument should call goroutine deriver`
 }
+
+var packageTasks = collectTasks(context.Background())
@@ -0,0 +1,138 @@
+// Package gotaskfirst contains test fixtures for the -gotask-deriver-first mode.
+package gotaskfirst
+
+import (
+	"context"
+
+	"github.com/my-example-app/telemetry/apm"
+	gotask "github.com/siketyan/gotask/v2"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Deriver after another statement
+//
+// The deriver is called, but a statement runs before it.
+func badDeriverAfterStatement(ctx context.Context) {
+	_ = gotask.DoAllFnsSettled( // want `goroutine deriver should be the first statement`
+		ctx,
+		func(ctx context.Context) error {
+			prepare()
+			_ = apm.NewGoroutineContext(ctx)
+			return nil
+		},
+	)
+}
+
+// [BAD]: Deriver only on one branch
+//
+// A conditional deriver call satisfies the regular check but may never run.
+func badDeriverOnOneBranch(ctx context.Context) {
+	_ = gotask.DoAllFnsSettled( // want `goroutine deriver should be the first statement`
+		ctx,
+		func(ctx context.Context) error {
+			if false {
+				_ = apm.NewGoroutineContext(ctx)
+			}
+			return nil
+		},
+	)
+}
+
+// [BAD]: Deriver not first in one of several tasks
+//
+// Each task closure is checked on its own.
+func badDeriverNotFirstInSecondTask(ctx context.Context) {
+	_ = gotask.DoAllFnsSettled( // want `goroutine deriver should be the first statement`
+		ctx,
+		func(ctx context.Context) error {
+			_ = apm.NewGoroutineContext(ctx)
+			return nil
+		},
+		func(ctx context.Context) error {
+			prepare()
+			_ = apm.NewGoroutineContext(ctx)
+			return nil
+		},
+	)
+}
+
+// [BAD]: DoAsync task with deriver not first
+//
+// The callback passed to gotask.NewTask is checked for DoAsync.
+func badDoAsyncDeriverNotFirst(ctx context.Context) {
+	task := gotask.NewTask(func(ctx context.Context) error {
+		prepare()
+		_ = apm.NewGoroutineContext(ctx)
+		return nil
+	})
+	task.DoAsync(ctx, nil) // want `goroutine deriver should be the first statement`
+}
+
+// [BAD]: Task variable with deriver not first
+//
+// Closures held in variables are traced.
+func badVariableDeriverNotFirst(ctx context.Context) {
+	fn := func(ctx context.Context) error {
+		prepare()
+		_ = apm.NewGoroutineContext(ctx)
+		return nil
+	}
+	_ = gotask.DoAllFnsSettled(ctx, fn) // want `goroutine deriver should be the first statement`
+}
+
+// [BAD]: Task without any deriver
+//
+// Closures without a deriver call get only the regular diagnostic.
+func badNoDeriver(ctx context.Context) {
+	_ = gotask.DoAllFnsSettled( // want `gotask\.DoAllFnsSettled\(\) 2nd argument should call goroutine deriver`
+		ctx,
+		func(ctx context.Context) error {
+			prepare()
+			return nil
+		},
+	)
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Deriver as the first statement
+//
+// The deriver runs before anything else in the task.
+func goodDeriverFirst(ctx context.Context) {
+	_ = gotask.DoAllFnsSettled(
+		ctx,
+		func(ctx context.Context) error {
+			ctx = apm.NewGoroutineContext(ctx)
+			prepare()
+			_ = ctx
+			return nil
+		},
+	)
+}
+
+// [GOOD]: Deriver first in DoAsync task
+//
+// The NewTask callback calls the deriver first.
+func goodDoAsyncDeriverFirst(ctx context.Context) {
+	task := gotask.NewTask(func(ctx context.Context) error {
+		_ = apm.NewGoroutineContext(ctx)
+		prepare()
+		return nil
+	})
+	task.DoAsync(ctx, nil)
+}
+
+// [GOOD]: DoAsync with deriver as ctx argument
+//
+// The ctx argument is derived at the call site, so the closure needs no deriver.
+func goodDoAsyncDerivedArg(ctx context.Context) {
+	task := gotask.NewTask(func(ctx context.Context) error {
+		prepare()
+		return nil
+	})
+	task.DoAsync(apm.NewGoroutineContext(ctx), nil)
+}
+
+//vt:helper
+func prepare() {}
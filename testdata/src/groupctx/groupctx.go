@@ -0,0 +1,91 @@
+// Package groupctx tests the groupctx checker.
+package groupctx
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Group context discarded with blank identifier
+//
+// Goroutines are not canceled when one of them fails.
+func badGroupCtxDiscarded(ctx context.Context) error {
+	g, _ := errgroup.WithContext(ctx) // want `errgroup group context is never used`
+	g.Go(func() error {
+		return doWork(ctx)
+	})
+	return g.Wait()
+}
+
+// [BAD]: Group context only assigned to blank
+//
+// Silencing the compiler with "_ = gctx" does not use the context.
+func badGroupCtxBlankAssigned(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx) // want `errgroup group context is never used`
+	_ = gctx
+	g.Go(func() error {
+		return doWork(ctx)
+	})
+	return g.Wait()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Group context used in closure
+//
+// The derived context is captured by the goroutine.
+func goodGroupCtxUsedInClosure(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return doWork(gctx)
+	})
+	return g.Wait()
+}
+
+// [GOOD]: Group context passed down
+//
+// The derived context is passed to another function.
+func goodGroupCtxPassedDown(ctx context.Context) error {
+	g, gctx := errgroup.WithContext(ctx)
+	startWorkers(gctx, g)
+	return g.Wait()
+}
+
+// [GOOD]: Group context shadows ctx
+//
+// Re-binding ctx to the group context is a common idiom.
+func goodGroupCtxShadowsCtx(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return doWork(ctx)
+	})
+	return g.Wait()
+}
+
+// [GOOD]: Group context discarded with ignore directive
+//
+// The directive suppresses the groupctx checker.
+func goodGroupCtxIgnored(ctx context.Context) error {
+	g, _ := errgroup.WithContext(ctx) //goroutinectx:ignore groupctx
+	g.Go(func() error {
+		return doWork(ctx)
+	})
+	return g.Wait()
+}
+
+//vt:helper
+func doWork(ctx context.Context) error {
+	fmt.Println("work")
+	return ctx.Err()
+}
+
+//vt:helper
+func startWorkers(ctx context.Context, g *errgroup.Group) {
+	g.Go(func() error {
+		return doWork(ctx)
+	})
+}
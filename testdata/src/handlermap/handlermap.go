@@ -0,0 +1,115 @@
+// Package handlermap tests the handlermap checker.
+package handlermap
+
+import (
+	"context"
+	"errors"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Dispatch map of func() error handlers
+//
+// Handlers that ignore the context in scope lose cancellation.
+func badErrorHandlerMap(ctx context.Context, name string) error {
+	handlers := map[string]func() error{
+		"sync": func() error { // want `dispatch map handler should accept context.Context`
+			return doSync()
+		},
+		"ping": func() error {
+			return ping(ctx)
+		},
+	}
+	return handlers[name]()
+}
+
+// [BAD]: Dispatch map of func() handlers
+//
+// Handlers without results are checked the same way.
+func badVoidHandlerMap(ctx context.Context, name string) {
+	handlers := map[string]func(){
+		"flush": func() {}, // want `dispatch map handler should accept context.Context`
+	}
+	handlers[name]()
+	_ = ctx
+}
+
+// [BAD]: Dispatch map in goroutine
+//
+// The closure in the goroutine still has ctx in scope.
+func badHandlerMapInGoroutine(ctx context.Context) {
+	go func() {
+		_ = ctx
+		handlers := map[int]func() error{
+			1: func() error { return errors.New("x") }, // want `dispatch map handler should accept context.Context`
+		}
+		_ = handlers[1]()
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Dispatch map of context-taking handlers
+//
+// Each handler receives the context when invoked.
+func goodCtxHandlerMap(ctx context.Context, name string) error {
+	handlers := map[string]func(context.Context) error{
+		"sync": func(ctx context.Context) error {
+			return ctx.Err()
+		},
+	}
+	return handlers[name](ctx)
+}
+
+// [GOOD]: Handlers capturing ctx
+//
+// Every handler uses the context in scope.
+func goodCapturingHandlerMap(ctx context.Context, name string) error {
+	handlers := map[string]func() error{
+		"ping": func() error { return ping(ctx) },
+	}
+	return handlers[name]()
+}
+
+// [GOOD]: Slice of funcs
+//
+// Only maps are treated as dispatch tables.
+func goodFuncSlice(ctx context.Context) {
+	tasks := []func() error{
+		func() error { return doSync() },
+	}
+	_ = tasks
+	_ = ctx
+}
+
+// [NOTCHECKED]: No ctx in scope
+//
+// Dispatch maps are only checked where a context is in scope.
+func notCheckedNoCtx(name string) error {
+	handlers := map[string]func() error{
+		"sync": func() error { return doSync() },
+	}
+	return handlers[name]()
+}
+
+// [GOOD]: Ignored handler
+//
+// The directive suppresses the report.
+func goodIgnoredHandler(ctx context.Context, name string) error {
+	handlers := map[string]func() error{
+		//goroutinectx:ignore handlermap
+		"sync": func() error { return doSync() },
+	}
+	_ = ctx
+	return handlers[name]()
+}
+
+//vt:helper
+func doSync() error {
+	return nil
+}
+
+//vt:helper
+func ping(ctx context.Context) error {
+	return ctx.Err()
+}
@@ -0,0 +1,101 @@
+// Package httphandler tests ServeHTTP methods, where the request carries the context.
+package httphandler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+type dropHandler struct{}
+
+// [BAD]: Goroutine in ServeHTTP dropping the request
+//
+// r.Context() is in scope but the goroutine ignores it.
+func (*dropHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	go func() { // want `goroutine does not propagate context "r.Context\(\)"`
+		fmt.Println("background work")
+	}()
+}
+
+type errgroupHandler struct{}
+
+// [BAD]: errgroup closure in ServeHTTP dropping the request
+//
+// Spawner callbacks see the request context too.
+func (errgroupHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "req.Context\(\)"`
+		return nil
+	})
+	_ = g.Wait()
+}
+
+type logHandler struct{}
+
+// [BAD]: slog without the request context in ServeHTTP
+//
+// The suggested fix passes r.Context().
+func (*logHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	slog.Info("handled") // want `use slog.InfoContext with context "r.Context\(\)" instead of slog.Info`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+type ctxHandler struct{}
+
+// [GOOD]: Goroutine in ServeHTTP using r.Context()
+//
+// The goroutine observes request cancellation.
+func (*ctxHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	go func() {
+		<-ctx.Done()
+	}()
+}
+
+type requestHandler struct{}
+
+// [GOOD]: Goroutine in ServeHTTP capturing the request
+//
+// The request itself counts as a context carrier.
+func (*requestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	go func() {
+		fmt.Println(r.URL.Path)
+	}()
+}
+
+type unnamedHandler struct{}
+
+// [GOOD]: ServeHTTP with blank request parameter
+//
+// There is no request to take the context from.
+func (*unnamedHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	go func() {
+		fmt.Println("no request")
+	}()
+}
+
+type otherServer struct{}
+
+// [GOOD]: ServeHTTP with a different signature
+//
+// Only methods matching http.Handler are recognized.
+func (*otherServer) ServeHTTP(addr string) {
+	go func() {
+		fmt.Println(addr)
+	}()
+}
+
+// [GOOD]: Handler function outside ServeHTTP
+//
+// Plain functions taking a request are left to -context-carriers.
+func handle(w http.ResponseWriter, r *http.Request) {
+	go func() {
+		fmt.Println("not a ServeHTTP method")
+	}()
+}
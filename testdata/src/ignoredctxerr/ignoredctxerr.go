@@ -0,0 +1,77 @@
+// Package ignoredctxerr tests the ignoredctxerr checker.
+package ignoredctxerr
+
+import (
+	"context"
+	"fmt"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: ctx.Err() assigned to blank identifier in goroutine
+//
+// The goroutine checks for cancellation but keeps working.
+func badBlankCtxErrInGoroutine(ctx context.Context) {
+	go func() {
+		for i := 0; i < 10; i++ {
+			_ = ctx.Err() // want `ctx.Err\(\) result is ignored`
+			fmt.Println(i)
+		}
+	}()
+}
+
+// [BAD]: Bare ctx.Err() expression statement
+//
+// The result is dropped entirely.
+func badBareCtxErr(ctx context.Context) {
+	ctx.Err() // want `ctx.Err\(\) result is ignored`
+	fmt.Println("working")
+}
+
+// [BAD]: ctx.Err() on a derived context
+//
+// Any context.Context value is checked, not only the parameter.
+func badDerivedCtxErr(ctx context.Context) {
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+	_ = child.Err() // want `ctx.Err\(\) result is ignored`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: ctx.Err() checked before continuing
+//
+// The goroutine stops on cancellation.
+func goodCheckedCtxErr(ctx context.Context) {
+	go func() {
+		for i := 0; i < 10; i++ {
+			if err := ctx.Err(); err != nil {
+				return
+			}
+			fmt.Println(i)
+		}
+	}()
+}
+
+// [GOOD]: ctx.Err() returned
+//
+// The error is propagated to the caller.
+func goodReturnedCtxErr(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// [GOOD]: Err() on a non-context type
+//
+// Only context.Context receivers are checked.
+func goodNonContextErr(ctx context.Context, r interface{ Err() error }) {
+	_ = r.Err()
+	_ = ctx
+}
+
+// [GOOD]: Ignored ctx.Err() with ignore directive
+//
+// The directive suppresses the diagnostic.
+func goodIgnoredCtxErrDirective(ctx context.Context) {
+	//goroutinectx:ignore ignoredctxerr - touched for coverage only
+	_ = ctx.Err()
+}
@@ -0,0 +1,77 @@
+// Package initgoroutine contains test fixtures for the initgoroutine checker.
+package initgoroutine
+
+import (
+	"context"
+	"net/http"
+)
+
+//vt:helper
+func backgroundInit() {}
+
+//vt:helper
+func register(fn func()) bool {
+	return fn != nil
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Goroutines spawned from init
+//
+// They run for the lifetime of the process and can never be canceled,
+// including from immediately invoked func literals and local initializers.
+func init() {
+	go backgroundInit() // want `goroutine spawned during package initialization cannot be canceled`
+
+	func() {
+		go func() { // want `goroutine spawned during package initialization cannot be canceled`
+			backgroundInit()
+		}()
+	}()
+
+	var started = func() bool {
+		go backgroundInit() // want `goroutine spawned during package initialization cannot be canceled`
+		return true
+	}()
+	_ = started
+
+	// The handler runs per request, not during initialization
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		go backgroundInit()
+	})
+}
+
+// Goroutine spawned from an immediately invoked var initializer.
+var _ = func() bool {
+	go backgroundInit() // want `goroutine spawned during package initialization cannot be canceled`
+	return true
+}()
+
+// ===== SHOULD NOT REPORT =====
+
+// Goroutine in a func literal stored in a package-level var, not invoked during initialization.
+var start = func() {
+	go backgroundInit()
+}
+
+// Goroutine in a func literal passed to a call in a var initializer, not invoked there.
+var _ = register(func() {
+	go backgroundInit()
+})
+
+// [GOOD]: Goroutine in a regular function
+//
+// Only initialization code is reported.
+func run(ctx context.Context) {
+	go func() {
+		_ = ctx
+		start()
+	}()
+}
+
+type worker struct{}
+
+// A method named init is never run by package initialization.
+func (worker) init() {
+	go backgroundInit()
+}
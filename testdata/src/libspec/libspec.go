@@ -0,0 +1,93 @@
+// Package libspec tests configuring a library entirely through a library
+// spec bundle (ctxrelay-libs.yaml).
+package libspec
+
+import (
+	"context"
+
+	"github.com/example/jobqueue"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Spawner from bundle
+//
+// Queue.Spawn is declared as a spawner, so its func argument must derive
+// its context.
+func badSpawner(ctx context.Context, q *jobqueue.Queue) {
+	q.Spawn(func() { // want `Spawn\(\) func argument should use context "ctx" or call goroutine deriver`
+	})
+}
+
+// [BAD]: Deriver from bundle
+//
+// WithJob is declared as the goroutine deriver.
+func badDeriver(ctx context.Context) {
+	go func() { // want "goroutine should call github.com/example/jobqueue.WithJob to derive context"
+		_ = ctx
+	}()
+}
+
+// [BAD]: Carrier from bundle
+//
+// jobqueue.Job is declared as a context carrier, so j puts a context in scope.
+func badCarrier(j jobqueue.Job) {
+	go func() { // want `goroutine does not propagate context "j"` "goroutine should call github.com/example/jobqueue.WithJob to derive context"
+	}()
+}
+
+// [BAD]: Ctx-required argument from bundle
+//
+// Enqueue must be given the context in scope.
+func badCtxRequired(ctx context.Context) {
+	jobqueue.Enqueue(context.Background(), "report") // want `pass context "ctx" to Enqueue`
+}
+
+// [BAD]: Blocking function from bundle
+//
+// Queue.Wait is declared as blocking.
+func badBlocking(ctx context.Context, q *jobqueue.Queue) {
+	go func() {
+		ctx := jobqueue.WithJob(ctx)
+		_ = ctx
+		q.Wait() // want `blocking I/O in goroutine cannot observe context cancellation`
+	}()
+}
+
+// [BAD]: Closure store function from bundle
+//
+// Queue.OnIdle is declared as storing its closure long-term.
+func badClosureStore(ctx context.Context, q *jobqueue.Queue) {
+	q.OnIdle(func() { // want `closure capturing request context stored in long-lived registry`
+		jobqueue.Enqueue(ctx, "report")
+	})
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Library used as declared
+//
+// The goroutine derives its context and passes it on.
+func goodDerivedAndPassed(ctx context.Context) {
+	go func() {
+		ctx := jobqueue.WithJob(ctx)
+		jobqueue.Enqueue(ctx, "report")
+	}()
+}
+
+// [GOOD]: Spawner with derived ctx
+//
+// The spawned func derives its context from ctx.
+func goodSpawner(ctx context.Context, q *jobqueue.Queue) {
+	q.Spawn(func() {
+		ctx := jobqueue.WithJob(ctx)
+		jobqueue.Enqueue(ctx, "report")
+	})
+}
+
+// [GOOD]: Carrier context passed
+//
+// The context carried by j is passed to Enqueue.
+func goodCarrier(j jobqueue.Job) {
+	jobqueue.Enqueue(j.Context(), j.Name())
+}
@@ -0,0 +1,106 @@
+// Package localctx contains test fixtures for the -track-local-contexts flag.
+package localctx
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Short variable declaration of context
+//
+// A context declared with := is in scope after the declaration.
+func badShortVarDecl() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_ = ctx
+	go func() { // want `goroutine does not propagate context "ctx"`
+	}()
+}
+
+// [BAD]: Type switch binding a context
+//
+// The type switch variable is a context within its case clause.
+func badTypeSwitch(v any) {
+	switch c := v.(type) {
+	case context.Context:
+		_ = c
+		g := new(errgroup.Group)
+		g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "c"`
+			return nil
+		})
+		_ = g.Wait()
+	}
+}
+
+// [BAD]: Local context alongside a parameter
+//
+// Messages name the parameter first.
+func badLocalAndParam(ctx context.Context) {
+	child := context.WithoutCancel(ctx)
+	_ = child
+	go func() { // want `goroutine does not propagate context "ctx"`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Closure using short variable declaration context
+//
+// Closures using the local context propagate it.
+func goodShortVarDecl() {
+	ctx := context.Background()
+	go func() {
+		_ = ctx
+	}()
+}
+
+// [GOOD]: Closure using type switch context
+//
+// Closures using the type switch variable propagate it.
+func goodTypeSwitch(v any) {
+	switch c := v.(type) {
+	case context.Context:
+		g := new(errgroup.Group)
+		g.Go(func() error {
+			return c.Err()
+		})
+		_ = g.Wait()
+	}
+}
+
+// [GOOD]: Goroutine before the context is declared
+//
+// The local context is not yet visible.
+func goodBeforeDeclaration() {
+	go func() {
+	}()
+	ctx := context.Background()
+	_ = ctx
+}
+
+// [GOOD]: Type switch clause not binding a context
+//
+// Other case clauses have no context in scope.
+func goodOtherTypeSwitchClause(v any) {
+	switch c := v.(type) {
+	case string:
+		_ = c
+		go func() {
+		}()
+	}
+}
+
+// [GOOD]: Goroutine outside the declaring block
+//
+// The local context is only visible in its block.
+func goodOutsideBlock(cond bool) {
+	if cond {
+		ctx := context.Background()
+		_ = ctx
+	}
+	go func() {
+	}()
+}
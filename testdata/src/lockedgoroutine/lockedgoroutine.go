@@ -0,0 +1,243 @@
+// Package lockedgoroutine contains test fixtures for the lockedgoroutine checker.
+package lockedgoroutine
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+//vt:helper
+func refresh(wg *sync.WaitGroup) {
+	defer wg.Done()
+}
+
+//vt:helper
+func compute(done chan<- struct{}) {
+	close(done)
+}
+
+type cache struct {
+	mu    sync.Mutex
+	rw    sync.RWMutex
+	items map[string]int
+}
+
+type counter struct {
+	sync.Mutex
+	n int
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: WaitGroup waited under lock
+//
+// The goroutine is waited for before the lock it was spawned under is released.
+func badWaitGroupUnderLock(c *cache) {
+	var wg sync.WaitGroup
+	c.mu.Lock()
+	wg.Add(1)
+	go refresh(&wg) // want `goroutine awaited while holding lock may deadlock`
+	wg.Wait()
+	c.mu.Unlock()
+}
+
+// [BAD]: Deferred unlock
+//
+// A deferred Unlock holds the lock until the function returns.
+func badDeferredUnlock(c *cache) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go refresh(&wg) // want `goroutine awaited while holding lock may deadlock`
+	wg.Wait()
+}
+
+// [BAD]: Read lock
+//
+// RLock blocks writers the goroutine may need.
+func badReadLock(c *cache) {
+	var wg sync.WaitGroup
+	c.rw.RLock()
+	wg.Add(1)
+	go refresh(&wg) // want `goroutine awaited while holding lock may deadlock`
+	wg.Wait()
+	c.rw.RUnlock()
+}
+
+// [BAD]: Embedded mutex
+//
+// Lock methods promoted from an embedded mutex count.
+func badEmbeddedMutex(c *counter) {
+	var wg sync.WaitGroup
+	c.Lock()
+	wg.Add(1)
+	go func() { // want `goroutine awaited while holding lock may deadlock`
+		defer wg.Done()
+		c.n++
+	}()
+	wg.Wait()
+	c.Unlock()
+}
+
+// [BAD]: Channel receive under lock
+//
+// Receiving the goroutine's result waits for it.
+func badChannelRecvUnderLock(c *cache) {
+	done := make(chan struct{})
+	c.mu.Lock()
+	go compute(done) // want `goroutine awaited while holding lock may deadlock`
+	<-done
+	c.mu.Unlock()
+}
+
+// [BAD]: Range over channel under lock
+//
+// Ranging over the goroutine's results waits for it to close the channel.
+func badRangeUnderLock(c *cache) {
+	results := make(chan int)
+	c.mu.Lock()
+	go func() { // want `goroutine awaited while holding lock may deadlock`
+		defer close(results)
+		results <- 1
+	}()
+	for r := range results {
+		c.items["r"] = r
+	}
+	c.mu.Unlock()
+}
+
+// [BAD]: Select without ctx.Done
+//
+// A select without a ctx.Done() or default case waits unboundedly.
+func badSelectWithoutDone(c *cache) {
+	done := make(chan struct{})
+	other := make(chan struct{})
+	c.mu.Lock()
+	go compute(done) // want `goroutine awaited while holding lock may deadlock`
+	select {
+	case <-done:
+	case <-other:
+	}
+	c.mu.Unlock()
+}
+
+// [BAD]: Errgroup wait under lock
+//
+// The Wait method of errgroup.Group waits as well.
+func badErrgroupWaitUnderLock(c *cache) {
+	var g errgroup.Group
+	done := make(chan struct{})
+	c.mu.Lock()
+	go compute(done) // want `goroutine awaited while holding lock may deadlock`
+	_ = g.Wait()
+	c.mu.Unlock()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Unlock before wait
+//
+// The lock is released before the goroutine is waited for.
+func goodUnlockBeforeWait(c *cache) {
+	var wg sync.WaitGroup
+	c.mu.Lock()
+	wg.Add(1)
+	go refresh(&wg)
+	c.mu.Unlock()
+	wg.Wait()
+}
+
+// [GOOD]: Spawned before lock
+//
+// The goroutine is spawned before the lock is taken.
+func goodSpawnedBeforeLock(c *cache) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go refresh(&wg)
+	c.mu.Lock()
+	wg.Wait()
+	c.mu.Unlock()
+}
+
+// [GOOD]: Not awaited
+//
+// A goroutine spawned under the lock but not waited for can't deadlock on it.
+func goodNotAwaited(c *cache) {
+	var wg sync.WaitGroup
+	c.mu.Lock()
+	wg.Add(1)
+	go refresh(&wg)
+	c.mu.Unlock()
+}
+
+// [GOOD]: Select with ctx.Done
+//
+// A context timeout bounds the wait.
+func goodSelectWithCtxDone(ctx context.Context, c *cache) {
+	done := make(chan struct{})
+	c.mu.Lock()
+	go compute(done)
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	c.mu.Unlock()
+}
+
+// [GOOD]: Select with default
+//
+// A select with a default case does not wait.
+func goodSelectWithDefault(c *cache) {
+	done := make(chan struct{})
+	c.mu.Lock()
+	go compute(done)
+	select {
+	case <-done:
+	default:
+	}
+	c.mu.Unlock()
+}
+
+// [GOOD]: Wait inside goroutine
+//
+// Waits inside func literals run later and are not waits of the block.
+func goodWaitInsideGoroutine(c *cache) {
+	var wg sync.WaitGroup
+	c.mu.Lock()
+	wg.Add(1)
+	go refresh(&wg)
+	c.mu.Unlock()
+	go func() {
+		wg.Wait()
+	}()
+}
+
+// [GOOD]: Condition variable wait
+//
+// sync.Cond.Wait releases its lock while waiting.
+func goodCondWait(c *cache, cond *sync.Cond) {
+	var wg sync.WaitGroup
+	c.mu.Lock()
+	wg.Add(1)
+	go refresh(&wg)
+	cond.Wait()
+	c.mu.Unlock()
+}
+
+// [LIMITATION]: Goroutine spawned in a nested block
+//
+// Only go statements directly in the locked block are tracked.
+func limitationNestedBlock(c *cache, parallel bool) {
+	var wg sync.WaitGroup
+	c.mu.Lock()
+	if parallel {
+		wg.Add(1)
+		go refresh(&wg)
+	}
+	wg.Wait()
+	c.mu.Unlock()
+}
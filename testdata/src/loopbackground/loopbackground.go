@@ -0,0 +1,106 @@
+// Package loopbackground tests the loopbackground checker.
+package loopbackground
+
+import (
+	"context"
+	"fmt"
+)
+
+//vt:helper
+func process(ctx context.Context, x int) {
+	_ = ctx
+	fmt.Println(x)
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: context.Background() in range loop
+//
+// Each iteration drops the caller's context.
+func badBackgroundInRangeLoop(ctx context.Context, xs []int) {
+	for _, x := range xs {
+		ctx := context.Background() // want `recreating context.Background\(\) in loop; use "ctx"`
+		process(ctx, x)
+	}
+}
+
+// [BAD]: context.TODO() in for loop
+//
+// TODO is as detached as Background.
+func badTODOInForLoop(ctx context.Context) {
+	for i := 0; i < 3; i++ {
+		process(context.TODO(), i) // want `recreating context.TODO\(\) in loop; use "ctx"`
+	}
+}
+
+// [BAD]: context.Background() in nested block of loop
+//
+// Blocks inside the loop body run once per iteration too.
+func badBackgroundInLoopBranch(ctx context.Context, xs []int) {
+	for _, x := range xs {
+		if x > 0 {
+			process(context.Background(), x) // want `recreating context.Background\(\) in loop; use "ctx"`
+		}
+	}
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Outer ctx shared across iterations
+//
+// The loop uses the context in scope.
+func goodSharedCtxInLoop(ctx context.Context, xs []int) {
+	for _, x := range xs {
+		process(ctx, x)
+	}
+}
+
+// [GOOD]: context.Background() outside loop
+//
+// Creating the context once is not reported by this rule.
+func goodBackgroundBeforeLoop(ctx context.Context, xs []int) {
+	bg := context.Background()
+	for _, x := range xs {
+		process(bg, x)
+	}
+}
+
+// [GOOD]: context.Background() in loop header
+//
+// The for statement init runs once.
+func goodBackgroundInLoopInit(ctx context.Context) {
+	for c, i := context.Background(), 0; i < 3; i++ {
+		process(c, i)
+	}
+}
+
+// [GOOD]: context.Background() in loop without ctx in scope
+//
+// There is no context to share.
+func goodBackgroundInLoopNoCtx(xs []int) {
+	for _, x := range xs {
+		process(context.Background(), x)
+	}
+}
+
+// [GOOD]: context.Background() in closure defined in loop
+//
+// The closure body is a separate function, not evaluated per iteration here.
+func goodBackgroundInClosureInLoop(ctx context.Context, xs []int) {
+	for _, x := range xs {
+		fn := func() {
+			process(context.Background(), x)
+		}
+		_ = fn
+	}
+}
+
+// [GOOD]: context.Background() in loop with ignore directive
+//
+// The directive suppresses the diagnostic.
+func goodBackgroundInLoopIgnored(ctx context.Context, xs []int) {
+	for _, x := range xs {
+		//goroutinectx:ignore loopbackground - each job must outlive the request
+		process(context.Background(), x)
+	}
+}
@@ -0,0 +1,76 @@
+// Package messagestyle tests -message-style=unified wording.
+// Run with -goroutine-deriver=github.com/my-example-app/telemetry/apm.NewGoroutineContext
+package messagestyle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/my-example-app/telemetry/apm"
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Goroutine without ctx - unified wording
+//
+// The go statement is named like the errgroup and spawner constructs.
+func badGoroutineUnified(ctx context.Context) {
+	go func() { // want `go statement closure should use context "ctx"` `go statement closure should call goroutine deriver github.com/my-example-app/telemetry/apm.NewGoroutineContext`
+		fmt.Println("no ctx")
+	}()
+}
+
+// [BAD]: Deriver only in defer - unified wording
+//
+// The defer variant also names the go statement construct.
+func badDeriverInDeferUnified(ctx context.Context) {
+	go func() { // want `go statement closure should call goroutine deriver github.com/my-example-app/telemetry/apm.NewGoroutineContext at start, not in defer`
+		defer apm.NewGoroutineContext(ctx)
+	}()
+}
+
+// [BAD]: Errgroup without ctx - unchanged wording
+//
+// Checkers that already name the construct keep their messages.
+func badErrgroupUnchanged(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Goroutine starting from Background - unified wording
+//
+// The goroutine creates its own root context instead of propagating the outer one.
+func badGoroutineFreshBackgroundUnified(ctx context.Context) {
+	go func() { // want `goroutine creates a fresh context instead of propagating context "ctx"` `go statement closure should call goroutine deriver github.com/my-example-app/telemetry/apm.NewGoroutineContext`
+		ctx := context.Background()
+		fmt.Println(ctx)
+	}()
+}
+
+// [BAD]: Background only in nested closure - unified wording
+//
+// A root context created by a nested closure isn't attributed to the goroutine.
+func badGoroutineNestedBackgroundUnified(ctx context.Context) {
+	go func() { // want `go statement closure should use context "ctx"` `go statement closure should call goroutine deriver github.com/my-example-app/telemetry/apm.NewGoroutineContext`
+		run := func() {
+			fmt.Println(context.TODO())
+		}
+		run()
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Goroutine with ctx and deriver
+//
+// No diagnostic regardless of message style.
+func goodGoroutineWithDeriver(ctx context.Context) {
+	go func() {
+		ctx := apm.NewGoroutineContext(ctx)
+		_ = ctx
+	}()
+}
@@ -0,0 +1,61 @@
+// Package high tests -min-confidence=high, which keeps only drops proved directly from the AST.
+package high
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Goroutine without ctx (high confidence)
+//
+// The closure visibly ignores ctx.
+func badGoroutineWithoutCtx(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		fmt.Println("no ctx")
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Func variable merged across branches, below the threshold
+//
+// The SSA-traced failure has medium confidence.
+func goodMergedFuncWithoutCtx(ctx context.Context, fast bool) {
+	g := new(errgroup.Group)
+	withCtx := func() error { return ctx.Err() }
+	withoutCtx := func() error { return nil }
+	var fn func() error
+	if fast {
+		fn = withCtx
+	} else {
+		fn = withoutCtx
+	}
+	g.Go(fn)
+	_ = g.Wait()
+}
+
+// [GOOD]: Errgroup closure without ctx, below the threshold
+//
+// The SSA-traced verdict on the closure has medium confidence.
+func goodErrgroupClosureWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Context sent on a channel, below the threshold
+//
+// The heuristic failure has low confidence.
+func goodCtxSentOnChannel(ctx context.Context) context.Context {
+	ch := make(chan context.Context, 1)
+	go func() {
+		ch <- ctx
+	}()
+	return <-ch
+}
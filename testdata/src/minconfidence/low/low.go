@@ -0,0 +1,59 @@
+// Package low tests -min-confidence=low, which reports every diagnostic, including heuristic guesses.
+package low
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Goroutine without ctx (high confidence)
+//
+// The closure visibly ignores ctx.
+func badGoroutineWithoutCtx(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		fmt.Println("no ctx")
+	}()
+}
+
+// [BAD]: Func variable merged across branches (medium confidence)
+//
+// Only SSA shows that the spawned value may drop ctx.
+func badMergedFuncWithoutCtx(ctx context.Context, fast bool) {
+	g := new(errgroup.Group)
+	withCtx := func() error { return ctx.Err() }
+	withoutCtx := func() error { return nil }
+	var fn func() error
+	if fast {
+		fn = withCtx
+	} else {
+		fn = withoutCtx
+	}
+	g.Go(fn) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [BAD]: Errgroup closure without ctx (medium confidence)
+//
+// The verdict on the closure comes from tracing its SSA free variables.
+func badErrgroupClosureWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Context sent on a channel (low confidence)
+//
+// The handoff is only unusual, not necessarily wrong.
+func badCtxSentOnChannel(ctx context.Context) context.Context {
+	ch := make(chan context.Context, 1)
+	go func() {
+		ch <- ctx // want `sending context out of a goroutine via channel is unusual`
+	}()
+	return <-ch
+}
@@ -0,0 +1,61 @@
+// Package medium tests -min-confidence=medium, which drops heuristic guesses and keeps SSA-traced inferences.
+package medium
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Goroutine without ctx (high confidence)
+//
+// The closure visibly ignores ctx.
+func badGoroutineWithoutCtx(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		fmt.Println("no ctx")
+	}()
+}
+
+// [BAD]: Func variable merged across branches (medium confidence)
+//
+// Only SSA shows that the spawned value may drop ctx.
+func badMergedFuncWithoutCtx(ctx context.Context, fast bool) {
+	g := new(errgroup.Group)
+	withCtx := func() error { return ctx.Err() }
+	withoutCtx := func() error { return nil }
+	var fn func() error
+	if fast {
+		fn = withCtx
+	} else {
+		fn = withoutCtx
+	}
+	g.Go(fn) // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+	_ = g.Wait()
+}
+
+// [BAD]: Errgroup closure without ctx (medium confidence)
+//
+// The verdict on the closure comes from tracing its SSA free variables.
+func badErrgroupClosureWithoutCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Context sent on a channel, below the threshold
+//
+// The heuristic failure has low confidence.
+func goodCtxSentOnChannel(ctx context.Context) context.Context {
+	ch := make(chan context.Context, 1)
+	go func() {
+		ch <- ctx
+	}()
+	return <-ch
+}
@@ -0,0 +1,103 @@
+// Package nilctx tests the nilctx checker.
+package nilctx
+
+import (
+	"context"
+	"fmt"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Compare ctx == nil
+//
+// A context should never be nil, so the comparison hides a caller bug.
+func badCompareCtxEqualNil(ctx context.Context) {
+	if ctx == nil { // want `comparing context.Context to nil is usually a bug`
+		return
+	}
+	fmt.Println(ctx.Err())
+}
+
+// [BAD]: Compare ctx != nil
+//
+// Inequality comparisons are reported as well.
+func badCompareCtxNotEqualNil(ctx context.Context) {
+	if ctx != nil { // want `comparing context.Context to nil is usually a bug`
+		fmt.Println(ctx.Err())
+	}
+}
+
+// [BAD]: Compare nil == ctx
+//
+// Operand order does not matter.
+func badCompareNilEqualCtx(ctx context.Context) bool {
+	return nil == ctx // want `comparing context.Context to nil is usually a bug`
+}
+
+// [BAD]: Assign nil to ctx
+//
+// Clearing a context leaves downstream calls without cancellation.
+func badAssignNilToCtx(ctx context.Context) {
+	fmt.Println(ctx.Err())
+	ctx = nil // want `context.Context should not be assigned nil`
+	_ = ctx
+}
+
+// [BAD]: Declare ctx var with nil
+//
+// Explicit nil initialization of a context variable.
+func badDeclareCtxNil(ctx context.Context) {
+	var inner context.Context = nil // want `context.Context should not be assigned nil`
+	_ = inner
+	_ = ctx
+}
+
+// [BAD]: Nil guard without defaulting
+//
+// The guard only returns, so it is not the allowed defaulting pattern.
+func badNilGuardWithoutDefault(ctx context.Context) error {
+	if ctx == nil { // want `comparing context.Context to nil is usually a bug`
+		return fmt.Errorf("nil context")
+	}
+	return ctx.Err()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Nil guard defaulting to Background
+//
+// The "if ctx == nil { ctx = context.Background() }" guard is allowed by default.
+func goodNilGuardDefaultsToBackground(ctx context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	fmt.Println(ctx.Err())
+}
+
+// [GOOD]: Compare ctx.Err() to nil
+//
+// Only the context itself is checked, not values derived from it.
+func goodCompareErrToNil(ctx context.Context) {
+	if ctx.Err() != nil {
+		return
+	}
+}
+
+// [GOOD]: Pointer to context compared to nil
+//
+// A *context.Context may legitimately be nil.
+func goodComparePointerToNil(ctx context.Context, p *context.Context) {
+	if p == nil {
+		p = &ctx
+	}
+	_ = p
+}
+
+// [GOOD]: Compare ctx with ignore directive
+//
+// The directive suppresses the nilctx checker.
+func goodCompareIgnored(ctx context.Context) {
+	if ctx == nil { //goroutinectx:ignore nilctx
+		return
+	}
+}
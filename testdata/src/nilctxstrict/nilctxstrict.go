@@ -0,0 +1,17 @@
+// Package nilctxstrict tests the nilctx checker with -allow-nil-ctx-guard=false.
+package nilctxstrict
+
+import (
+	"context"
+	"fmt"
+)
+
+// [BAD]: Nil guard defaulting to Background - guard disallowed
+//
+// With the guard disallowed, the defaulting comparison is reported.
+func badNilGuardDefaultsToBackground(ctx context.Context) {
+	if ctx == nil { // want `comparing context.Context to nil is usually a bug`
+		ctx = context.Background()
+	}
+	fmt.Println(ctx.Err())
+}
@@ -0,0 +1,101 @@
+// Package printctx tests the printctx checker.
+package printctx
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+type key struct{}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: fmt.Println with ctx
+//
+// The whole context chain is printed, including stored values.
+func badPrintln(ctx context.Context) {
+	fmt.Println(ctx) // want `printing context.Context directly may leak internal values`
+}
+
+// [BAD]: fmt.Printf with %v
+//
+// The %v verb prints the context chain.
+func badPrintfV(ctx context.Context) {
+	fmt.Printf("request: %v\n", ctx) // want `printing context.Context directly may leak internal values`
+}
+
+// [BAD]: fmt.Sprintf with %+v
+//
+// Flags do not change what the %v verb prints.
+func badSprintfPlusV(ctx context.Context) string {
+	return fmt.Sprintf("id=%d ctx=%+v", 1, ctx) // want `printing context.Context directly may leak internal values`
+}
+
+// [BAD]: fmt.Fprintln with ctx
+//
+// The writer is skipped, the context operand is printed.
+func badFprintln(ctx context.Context) {
+	fmt.Fprintln(os.Stderr, "ctx:", ctx) // want `printing context.Context directly may leak internal values`
+}
+
+// [BAD]: fmt.Errorf with %v
+//
+// Errors carrying the context chain end up in logs.
+func badErrorf(ctx context.Context) error {
+	return fmt.Errorf("failed in %v", ctx) // want `printing context.Context directly may leak internal values`
+}
+
+// [BAD]: fmt.Println with ctx inside goroutine
+//
+// The goroutine inherits the outer context.
+func badPrintlnInGoroutine(ctx context.Context) {
+	go func() {
+		fmt.Println(ctx) // want `printing context.Context directly may leak internal values`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: fmt.Println with ctx.Value
+//
+// Only the selected value is printed.
+func goodPrintValue(ctx context.Context) {
+	fmt.Println(ctx.Value(key{}))
+}
+
+// [GOOD]: fmt.Println with ctx.Err
+//
+// The error does not contain context values.
+func goodPrintErr(ctx context.Context) {
+	fmt.Println(ctx.Err())
+}
+
+// [GOOD]: fmt.Printf with %p
+//
+// Only the verbs printing the context chain are reported.
+func goodPrintfPointer(ctx context.Context) {
+	fmt.Printf("%p\n", ctx)
+}
+
+// [GOOD]: fmt.Printf with ctx matched by a non-%v verb
+//
+// Operands are matched to verbs by position.
+func goodPrintfOtherOperand(ctx context.Context) {
+	fmt.Printf("%v %T\n", ctx.Value(key{}), ctx)
+}
+
+// [GOOD]: fmt.Printf with non-constant format
+//
+// The verbs cannot be determined.
+func goodPrintfDynamicFormat(ctx context.Context, format string) {
+	fmt.Printf(format, ctx)
+}
+
+// [GOOD]: fmt.Println with ignore directive
+//
+// The directive suppresses the diagnostic.
+func goodPrintlnIgnored(ctx context.Context) {
+	//goroutinectx:ignore printctx - debugging aid
+	fmt.Println(ctx)
+}
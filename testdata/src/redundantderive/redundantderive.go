@@ -0,0 +1,99 @@
+// Package redundantderive tests the redundantderive checker.
+// Run with -goroutine-deriver=github.com/my-example-app/telemetry/apm.NewGoroutineContext
+package redundantderive
+
+import (
+	"context"
+
+	"github.com/my-example-app/telemetry/apm"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Deriver called twice in sequence
+//
+// The second call re-derives the already derived context.
+func badDeriveTwice(ctx context.Context) {
+	go func() { // want `goroutine derives context more than once`
+		ctx := apm.NewGoroutineContext(ctx)
+		ctx = apm.NewGoroutineContext(ctx)
+		_ = ctx
+	}()
+}
+
+// [BAD]: Deriver called twice on parent ctx
+//
+// Deriving the parent context twice is also redundant.
+func badDeriveTwiceFromParent(ctx context.Context) {
+	go func() { // want `goroutine derives context more than once`
+		ctx1 := apm.NewGoroutineContext(ctx)
+		ctx2 := apm.NewGoroutineContext(ctx)
+		_, _ = ctx1, ctx2
+	}()
+}
+
+// [BAD]: Deriver called again after branch
+//
+// The first call runs on every path to the second.
+func badDeriveAgainAfterBranch(ctx context.Context, verbose bool) {
+	go func() { // want `goroutine derives context more than once`
+		ctx := apm.NewGoroutineContext(ctx)
+		if verbose {
+			_ = ctx
+		}
+		ctx = apm.NewGoroutineContext(ctx)
+		_ = ctx
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Deriver called once
+//
+// A single derivation is the expected pattern.
+func goodDeriveOnce(ctx context.Context) {
+	go func() {
+		ctx := apm.NewGoroutineContext(ctx)
+		_ = ctx
+	}()
+}
+
+// [GOOD]: Deriver called in exclusive branches
+//
+// Only one branch runs, so the context is derived once per path.
+func goodDeriveInExclusiveBranches(ctx context.Context, fast bool) {
+	go func() {
+		var gctx context.Context
+		if fast {
+			gctx = apm.NewGoroutineContext(ctx)
+		} else {
+			gctx = apm.NewGoroutineContext(context.WithoutCancel(ctx))
+		}
+		_ = gctx
+	}()
+}
+
+// [GOOD]: Nested goroutines each derive once
+//
+// Each goroutine derives its own context.
+func goodNestedGoroutines(ctx context.Context) {
+	go func() {
+		ctx := apm.NewGoroutineContext(ctx)
+		go func() {
+			ctx := apm.NewGoroutineContext(ctx)
+			_ = ctx
+		}()
+	}()
+}
+
+// [GOOD]: Deriver called twice with ignore directive
+//
+// The ignore directive suppresses the report.
+func goodDeriveTwiceIgnored(ctx context.Context) {
+	//goroutinectx:ignore redundantderive
+	go func() {
+		ctx := apm.NewGoroutineContext(ctx)
+		ctx = apm.NewGoroutineContext(ctx)
+		_ = ctx
+	}()
+}
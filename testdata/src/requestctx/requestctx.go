@@ -0,0 +1,70 @@
+// Package requestctx tests the requestctx checker.
+package requestctx
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Middleware replacing request context with Background
+//
+// Downstream handlers lose cancellation and request-scoped values.
+func badMiddlewareBackground(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.Background())) // want `do not replace request context with Background in middleware`
+	})
+}
+
+// [BAD]: Middleware replacing request context with TODO
+//
+// context.TODO() is as detached as context.Background().
+func badMiddlewareTODO(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.TODO())) // want `do not replace request context with Background in middleware`
+	})
+}
+
+// [BAD]: Handler cloning request with Background
+//
+// Request.Clone replaces the context the same way.
+func badHandlerClone(w http.ResponseWriter, r *http.Request) {
+	r2 := r.Clone(context.Background()) // want `do not replace request context with Background in middleware`
+	_ = r2
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Middleware deriving from request context
+//
+// The new context is derived from r.Context().
+func goodMiddlewareDerived(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), time.Second)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// [GOOD]: Outgoing request built with Background
+//
+// A request that is not a parameter of the handler is not rewritten.
+func goodOutgoingRequest() (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	if err != nil {
+		return nil, err
+	}
+	return req.WithContext(context.Background()), nil
+}
+
+// [GOOD]: Request context replacement with ignore
+//
+// The ignore directive suppresses the report.
+func goodMiddlewareIgnored(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		//goroutinectx:ignore requestctx - detached on purpose
+		next.ServeHTTP(w, r.WithContext(context.Background()))
+	})
+}
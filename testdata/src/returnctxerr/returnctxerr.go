@@ -0,0 +1,164 @@
+// Package returnctxerr tests the returnctxerr checker.
+package returnctxerr
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Done case returns nil
+//
+// Cancellation is swallowed, so Wait reports success.
+func badDoneReturnsNil(ctx context.Context, jobs <-chan int) {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error { // want `return ctx.Err\(\) on cancellation in errgroup closure`
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case j := <-jobs:
+				fmt.Println(j)
+			}
+		}
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: TryGo Done case returns nil
+//
+// TryGo callbacks are checked the same way.
+func badTryGoDoneReturnsNil(ctx context.Context, jobs <-chan int) {
+	g := new(errgroup.Group)
+	g.TryGo(func() error { // want `return ctx.Err\(\) on cancellation in errgroup closure`
+		for {
+			select {
+			case j := <-jobs:
+				fmt.Println(j)
+			case <-ctx.Done():
+				fmt.Println("stopping")
+				return nil
+			}
+		}
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Variable callback Done case returns nil
+//
+// The closure assigned to the variable is traced.
+func badVariableDoneReturnsNil(ctx context.Context, jobs <-chan int) {
+	g := new(errgroup.Group)
+	worker := func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case j := <-jobs:
+				fmt.Println(j)
+			}
+		}
+	}
+	g.Go(worker) // want `return ctx.Err\(\) on cancellation in errgroup closure`
+	_ = g.Wait()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Done case returns ctx.Err()
+//
+// Wait reports the cancellation.
+func goodDoneReturnsCtxErr(ctx context.Context, jobs <-chan int) {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case j := <-jobs:
+				fmt.Println(j)
+			}
+		}
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Done case returns context.Cause
+//
+// Any non-nil error is accepted.
+func goodDoneReturnsCause(ctx context.Context, jobs <-chan int) {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return context.Cause(ctx)
+			case j := <-jobs:
+				fmt.Println(j)
+			}
+		}
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Bounded loop returning nil on Done
+//
+// Only unbounded loops are checked.
+func goodBoundedLoop(ctx context.Context, items []int) {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		for _, item := range items {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fmt.Println(item)
+			}
+		}
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Returning nil from another case
+//
+// A closed jobs channel is a normal exit.
+func goodNilFromOtherCase(ctx context.Context, jobs <-chan int) {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case j, ok := <-jobs:
+				if !ok {
+					return nil
+				}
+				fmt.Println(j)
+			}
+		}
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Done case returns nil with ignore directive
+//
+// Shutdown is treated as success on purpose.
+func goodDoneReturnsNilIgnored(ctx context.Context, jobs <-chan int) {
+	g, ctx := errgroup.WithContext(ctx)
+	//goroutinectx:ignore returnctxerr - graceful shutdown is not an error
+	g.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case j := <-jobs:
+				fmt.Println(j)
+			}
+		}
+	})
+	_ = g.Wait()
+}
@@ -0,0 +1,52 @@
+package signal
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+
+	"github.com/labstack/echo/v4"
+)
+
+type signalHandler struct{}
+
+type signalServer struct {
+	ctx context.Context
+}
+
+// ===== SHOULD REPORT WITHOUT FIX =====
+
+// [BAD]: signal.Notify in ServeHTTP
+//
+// The request context is a call, which cannot be redeclared, so no fix is suggested.
+func (*signalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt) // want `use signal.NotifyContext with context "r.Context\(\)" instead of signal.Notify`
+}
+
+// [BAD]: signal.Notify with receiver ctx field
+//
+// A struct field cannot be redeclared, so no fix is suggested.
+func (s *signalServer) badSignalNotifyReceiverField() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt) // want `use signal.NotifyContext with context "s.ctx" instead of signal.Notify`
+}
+
+// [BAD]: signal.Notify with carrier
+//
+// A carrier is not a context.Context, so no fix is suggested.
+func badSignalNotifyCarrier(c echo.Context) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt) // want `use signal.NotifyContext with context "c" instead of signal.Notify`
+}
+
+// [BAD]: signal.Notify inside loop
+//
+// A deferred stop would pile up across iterations, so no fix is suggested.
+func badSignalNotifyInLoop(ctx context.Context, n int) {
+	for range n {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, os.Interrupt) // want `use signal.NotifyContext with context "ctx" instead of signal.Notify`
+	}
+}
@@ -0,0 +1,110 @@
+// Package signal tests the signal checker.
+package signal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: signal.Notify with ctx in scope
+//
+// An ad-hoc signal channel bypasses the context that is already available.
+// No fix is suggested while the channel is still read.
+func badSignalNotify(ctx context.Context) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt) // want `use signal.NotifyContext with context "ctx" instead of signal.Notify`
+	<-ch
+}
+
+// [BAD]: signal.Notify with multiple signals
+//
+// All signal arguments are preserved by the suggested fix.
+func badSignalNotifyMultipleSignals(ctx context.Context) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM) // want `use signal.NotifyContext with context "ctx" instead of signal.Notify`
+	<-ctx.Done()
+}
+
+// [BAD]: signal.Notify inside goroutine
+//
+// The goroutine inherits the enclosing context, so it should use signal.NotifyContext.
+func badSignalNotifyInGoroutine(ctx context.Context) {
+	go func() {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, syscall.SIGTERM) // want `use signal.NotifyContext with context "ctx" instead of signal.Notify`
+		select {
+		case <-ch:
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// [BAD]: signal.Notify with spread signals
+//
+// Spread signal arguments are preserved by the suggested fix.
+func badSignalNotifySpread(ctx context.Context, sigs ...os.Signal) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sigs...) // want `use signal.NotifyContext with context "ctx" instead of signal.Notify`
+	<-ctx.Done()
+}
+
+// [BAD]: signal.Notify with stop in scope
+//
+// The suggested fix numbers the stop function instead of shadowing the existing one.
+func badSignalNotifyStopInScope(ctx context.Context, stop func()) {
+	defer stop()
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt) // want `use signal.NotifyContext with context "ctx" instead of signal.Notify`
+	<-ctx.Done()
+}
+
+// [BAD]: signal.Notify with channel parameter
+//
+// The suggested fix keeps a channel parameter, which is not declared by the function.
+func badSignalNotifyChannelParam(ctx context.Context, ch chan os.Signal) {
+	signal.Notify(ch, os.Interrupt) // want `use signal.NotifyContext with context "ctx" instead of signal.Notify`
+	<-ctx.Done()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: signal.NotifyContext
+//
+// Cancellation flows through the derived context.
+func goodSignalNotifyContext(ctx context.Context) {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+	<-ctx.Done()
+}
+
+// [GOOD]: signal.Notify without ctx in scope
+//
+// No context is available to derive from.
+func goodSignalNotifyWithoutCtx() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt)
+	<-ch
+}
+
+// [GOOD]: signal.Stop and signal.Ignore
+//
+// Only signal.Notify is reported.
+func goodSignalOtherFuncs(ctx context.Context, ch chan os.Signal) {
+	signal.Stop(ch)
+	signal.Ignore(syscall.SIGHUP)
+	fmt.Println(ctx.Err())
+}
+
+// [GOOD]: signal.Notify with ignore directive
+//
+// The directive suppresses the signal checker.
+func goodSignalNotifyIgnored(ctx context.Context) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt) //goroutinectx:ignore signal
+	<-ch
+}
@@ -0,0 +1,16 @@
+package slogstructctx
+
+import (
+	"log/slog"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ===== SHOULD REPORT WITHOUT FIX =====
+
+// [BAD]: slog.Info with carrier
+//
+// A carrier is not a context.Context, so no fix is suggested.
+func badSlogCarrier(c echo.Context) {
+	slog.Info("handled") // want `use slog.InfoContext with context "c" instead of slog.Info`
+}
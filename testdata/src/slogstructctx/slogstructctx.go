@@ -0,0 +1,78 @@
+// Package slogstructctx tests the slogctx checker with -slog-struct-ctx.
+package slogstructctx
+
+import (
+	"context"
+	"log/slog"
+)
+
+type server struct {
+	ctx    context.Context
+	logger *slog.Logger
+}
+
+type plain struct {
+	name string
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: slog.Info in method with receiver ctx field
+//
+// The receiver carries a context that should be passed to slog.
+func (s *server) badReceiverField() {
+	slog.Info("handled") // want `use slog.InfoContext with context "s.ctx" instead of slog.Info`
+}
+
+// [BAD]: Logger method in method with receiver ctx field
+//
+// *slog.Logger level methods have ...Context variants too.
+func (s *server) badReceiverFieldLogger() {
+	s.logger.Warn("slow", "ms", 120) // want `use slog.Logger.WarnContext with context "s.ctx" instead of slog.Logger.Warn`
+}
+
+// [BAD]: slog.Error in closure inside method with receiver ctx field
+//
+// Closures inherit the receiver field scope.
+func (s server) badReceiverFieldClosure() {
+	func() {
+		slog.Error("failed") // want `use slog.ErrorContext with context "s.ctx" instead of slog.Error`
+	}()
+}
+
+// [BAD]: slog.Debug with ctx param
+//
+// A context parameter is in scope as usual.
+func badCtxParam(ctx context.Context) {
+	slog.Debug("start") // want `use slog.DebugContext with context "ctx" instead of slog.Debug`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: slog.InfoContext with receiver ctx field
+//
+// The receiver context is passed.
+func (s *server) goodReceiverFieldContext() {
+	slog.InfoContext(s.ctx, "handled")
+}
+
+// [GOOD]: slog.Info in method without ctx field
+//
+// The receiver struct has no context field.
+func (p *plain) goodNoCtxField() {
+	slog.Info("handled", "name", p.name)
+}
+
+// [GOOD]: slog.Info in plain function
+//
+// No context is in scope.
+func goodNoCtx() {
+	slog.Info("startup")
+}
+
+// [GOOD]: slog.Info with ignore directive
+//
+// The ignore directive suppresses the report.
+func (s *server) goodReceiverFieldIgnored() {
+	slog.Info("handled") //goroutinectx:ignore slogctx
+}
@@ -0,0 +1,82 @@
+// Package derive tests the //goroutinectx:spawner directive with -goroutine-deriver.
+package derive
+
+import (
+	"context"
+
+	"github.com/my-example-app/telemetry/apm"
+)
+
+// ===== SPAWNER FUNCTIONS =====
+
+//goroutinectx:spawner //vt:helper
+func runTask(ctx context.Context, fn func(context.Context)) {
+	go fn(ctx)
+}
+
+//goroutinectx:spawner //vt:helper
+func runJob(fn func()) {
+	go fn()
+}
+
+//vt:helper
+func doWork(ctx context.Context) {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Own context parameter without deriver
+//
+// The spawner passes the callback a context, so it must call the deriver.
+func badOwnContextNoDeriver(ctx context.Context) {
+	runTask(ctx, func(ctx context.Context) { // want `runTask\(\) func argument should call goroutine deriver`
+		doWork(ctx)
+	})
+}
+
+// [BAD]: Own context parameter through a variable
+//
+// Func literals assigned to a variable are checked as well.
+func badOwnContextVariable(ctx context.Context) {
+	fn := func(ctx context.Context) {
+		doWork(ctx)
+	}
+	runTask(ctx, fn) // want `runTask\(\) func argument should call goroutine deriver`
+}
+
+// [BAD]: Neither context nor deriver
+//
+// A callback without context gets a single diagnostic naming both fixes.
+func badNoContextNoDeriver(ctx context.Context) {
+	runJob(func() { // want `runJob\(\) func argument should use context "ctx" or call goroutine deriver`
+	})
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Own context parameter with deriver
+//
+// The callback derives the context it receives.
+func goodOwnContextWithDeriver(ctx context.Context) {
+	runTask(ctx, func(ctx context.Context) {
+		ctx = apm.NewGoroutineContext(ctx)
+		doWork(ctx)
+	})
+}
+
+// [GOOD]: Captured context
+//
+// Capturing the context in scope satisfies callbacks without a context parameter.
+func goodCapturesContext(ctx context.Context) {
+	runJob(func() {
+		doWork(ctx)
+	})
+}
+
+// [GOOD]: Deriver without captured context
+//
+// Calling the deriver satisfies callbacks without a context parameter.
+func goodCallsDeriverOnly(ctx context.Context) {
+	runJob(func() {
+		doWork(apm.NewGoroutineContext(context.Background()))
+	})
+}
@@ -31,6 +31,23 @@ func runMultipleFuncs(fn1, fn2 func()) {
 	go fn2()
 }
 
+//goroutinectx:spawner //vt:helper
+func spawnLater(ctx context.Context) func() {
+	return func() {
+		go doWork(ctx)
+	}
+}
+
+//vt:helper
+func doWork(ctx context.Context) {}
+
+//vt:helper
+func startLater(ctx context.Context) func() {
+	return func() {
+		go doWork(ctx)
+	}
+}
+
 // ===== SHOULD REPORT =====
 
 // [BAD]: Basic errgroup func context usage
@@ -302,3 +319,106 @@ func badInlineFactoryVariableWithoutCtx(ctx context.Context) {
 	runWithGroup(g, makeWorker()) // want `runWithGroup\(\) func argument should use context "ctx"`
 	_ = g.Wait()
 }
+
+// ===== INLINE STRUCT LITERAL FIELD =====
+
+type task struct {
+	name string
+	fn   func() error
+}
+
+// [BAD]: Inline struct literal field func without context
+//
+// The func literal is read from a field of an inline composite literal.
+func badInlineStructLiteralField(ctx context.Context) {
+	g := new(errgroup.Group)
+	runWithGroup(g, task{name: "t", fn: func() error { // want `runWithGroup\(\) func argument should use context "ctx"`
+		fmt.Println("no ctx")
+		return nil
+	}}.fn)
+	_ = g.Wait()
+}
+
+// [GOOD]: Inline struct literal field func with context
+//
+// The field func read from an inline composite literal captures ctx.
+func goodInlineStructLiteralField(ctx context.Context) {
+	g := new(errgroup.Group)
+	runWithGroup(g, task{name: "t", fn: func() error {
+		_ = ctx
+		return nil
+	}}.fn)
+	_ = g.Wait()
+}
+
+// [BAD]: Inline struct pointer literal field func without context
+//
+// Address-taken composite literals are traced the same way.
+func badInlineStructPointerLiteralField(ctx context.Context) {
+	g := new(errgroup.Group)
+	runWithGroup(g, (&task{fn: func() error { // want `runWithGroup\(\) func argument should use context "ctx"`
+		fmt.Println("no ctx")
+		return nil
+	}}).fn)
+	_ = g.Wait()
+}
+
+// [GOOD]: Inline struct literal with other field selected
+//
+// A non-func field selector cannot be traced and is assumed OK.
+func goodInlineStructLiteralUntracedField(ctx context.Context, fn func() error) {
+	g := new(errgroup.Group)
+	runWithGroup(g, task{name: "t", fn: fn}.fn)
+	_ = g.Wait()
+}
+
+// ===== PACKAGE FUNCTION REFERENCE =====
+
+//vt:helper
+func spawnedWork() error {
+	fmt.Println("no ctx")
+	return nil
+}
+
+// [BAD]: Package-level function passed to spawner by name
+//
+// A package-level function cannot capture the caller's context.
+func badPackageFuncReferenceToSpawner(ctx context.Context) {
+	g := new(errgroup.Group)
+	runWithGroup(g, spawnedWork) // want `runWithGroup\(\) func argument should use context "ctx"`
+	_ = g.Wait()
+}
+
+// ===== DEFERRED START =====
+
+// [BAD]: Deferred-start spawner given a fresh context
+//
+// The start func spawns goroutines carrying context.Background() instead of ctx.
+func badDeferredStartBackground(ctx context.Context) {
+	start := spawnLater(context.Background()) // want `spawnLater\(\) context argument should use context "ctx"`
+	start()
+}
+
+// [GOOD]: Deferred-start spawner given ctx
+//
+// The start func spawns goroutines carrying the context in scope.
+func goodDeferredStartCtx(ctx context.Context) {
+	start := spawnLater(ctx)
+	start()
+}
+
+// [GOOD]: Deferred-start spawner given a derived ctx
+//
+// Contexts derived from ctx are accepted.
+func goodDeferredStartDerived(ctx context.Context) {
+	start := spawnLater(context.WithoutCancel(ctx))
+	start()
+}
+
+// [GOOD]: Unmarked function returning a start func
+//
+// Only functions marked with //goroutinectx:spawner are checked.
+func goodDeferredStartUnmarked(ctx context.Context) {
+	start := startLater(context.Background())
+	start()
+}
@@ -0,0 +1,45 @@
+// Package handler uses spawner functions defined in package worker.
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"spawnerfacts/worker"
+)
+
+// [BAD]: Imported spawner function without ctx
+//
+// The directive in package worker is propagated through facts.
+func badImportedSpawnerFunc(ctx context.Context) {
+	worker.Go(func() { // want `Go\(\) func argument should use context "ctx"`
+		fmt.Println("no ctx")
+	})
+}
+
+// [BAD]: Imported spawner method without ctx
+//
+// Methods marked as spawners are propagated as well.
+func badImportedSpawnerMethod(ctx context.Context, p *worker.Pool) {
+	p.Go(func() { // want `Go\(\) func argument should use context "ctx"`
+		fmt.Println("no ctx")
+	})
+}
+
+// [GOOD]: Imported spawner function with ctx
+//
+// The closure uses the context.
+func goodImportedSpawnerFunc(ctx context.Context) {
+	worker.Go(func() {
+		_ = ctx
+	})
+}
+
+// [GOOD]: Imported non-spawner function
+//
+// Functions without the directive are not checked.
+func goodImportedNonSpawner(ctx context.Context) {
+	worker.Run(func() {
+		fmt.Println("no ctx")
+	})
+}
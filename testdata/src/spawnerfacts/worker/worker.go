@@ -0,0 +1,24 @@
+// Package worker defines spawner functions used by package handler.
+package worker
+
+// Go spawns fn as a goroutine.
+//
+//goroutinectx:spawner
+func Go(fn func()) {
+	go fn()
+}
+
+// Pool spawns tasks as goroutines.
+type Pool struct{}
+
+// Go spawns fn as a goroutine.
+//
+//goroutinectx:spawner
+func (p *Pool) Go(fn func()) {
+	go fn()
+}
+
+// Run calls fn synchronously (not a spawner).
+func Run(fn func()) {
+	fn()
+}
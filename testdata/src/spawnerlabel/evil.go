@@ -188,6 +188,17 @@ func goodFuncParamNoSpawn(callback func() error) error {
 	return callback()
 }
 
+// [GOOD]: Takes ctx and returns a start func - label is justified (deferred start)
+//
+//goroutinectx:spawner
+func goodDeferredStartSpawner(ctx context.Context) func() {
+	return func() {
+		go func() {
+			_ = ctx
+		}()
+	}
+}
+
 // [BAD]: Has label, no spawn, no func param
 //
 //goroutinectx:spawner
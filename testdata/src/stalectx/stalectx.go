@@ -0,0 +1,95 @@
+// Package stalectx tests the stalectx checker.
+package stalectx
+
+import (
+	"context"
+	"fmt"
+)
+
+type server struct {
+	ctx  context.Context
+	name string
+}
+
+//vt:helper
+func (s *server) loop() {
+	<-s.ctx.Done()
+}
+
+//vt:helper
+func (s *server) loopWith(ctx context.Context) {
+	<-ctx.Done()
+}
+
+//vt:helper
+func (s *server) log() {
+	fmt.Println(s.name)
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Method using receiver ctx field
+//
+// loop waits on s.ctx, so the ctx parameter of start is dropped.
+func (s *server) badStart(ctx context.Context) {
+	go s.loop() // want `goroutine uses a stale context, not the parameter "ctx"`
+}
+
+// [BAD]: Closure calling method using receiver ctx field
+//
+// The goroutine checker also reports that the closure does not capture ctx.
+func (s *server) badStartClosure(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"` `goroutine uses a stale context, not the parameter "ctx"`
+		s.log()
+		s.loop()
+	}()
+}
+
+// [BAD]: Field of another struct
+//
+// A server passed in holds a context unrelated to the one in scope.
+func badRun(ctx context.Context, s *server) {
+	go s.loop() // want `goroutine uses a stale context, not the parameter "ctx"`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Method receiving ctx parameter
+//
+// The parameter is passed explicitly.
+func (s *server) goodStartWith(ctx context.Context) {
+	go s.loopWith(ctx)
+}
+
+// [GOOD]: Field refreshed from parameter
+//
+// The field is assigned the context in scope before spawning.
+func (s *server) goodStartRefreshed(ctx context.Context) {
+	s.ctx = ctx
+	go s.loop()
+}
+
+// [GOOD]: Struct constructed with ctx in scope
+//
+// The worker field is initialized from the ctx parameter.
+func goodNewServer(ctx context.Context) {
+	s := &server{ctx: ctx, name: "srv"}
+	go s.loop()
+}
+
+// [GOOD]: Method not using ctx field
+//
+// Methods that never read a context field are left to other checkers.
+func (s *server) goodStartLog(ctx context.Context) {
+	go s.log()
+}
+
+// [GOOD]: Closure using ctx parameter
+//
+// The closure uses ctx itself alongside the method.
+func (s *server) goodStartClosureWithCtx(ctx context.Context) {
+	go func() {
+		s.loop()
+		<-ctx.Done()
+	}()
+}
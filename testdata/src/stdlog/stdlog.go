@@ -0,0 +1,107 @@
+// Package stdlog tests the stdlog checker without -preferred-logger.
+package stdlog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: log.Printf in goroutine
+//
+// The log line can't be correlated with the request the goroutine works for.
+func badLogPrintfInGoroutine(ctx context.Context, id int) {
+	go func() {
+		_ = ctx
+		log.Printf("done: %d", id) // want `use context-aware logger instead of log.Printf in goroutine`
+	}()
+}
+
+// [BAD]: log.Println in goroutine
+//
+// Every print function of the log package is reported.
+func badLogPrintlnInGoroutine(ctx context.Context) {
+	go func() {
+		_ = ctx
+		log.Println("done") // want `use context-aware logger instead of log.Println in goroutine`
+	}()
+}
+
+// [BAD]: log.Logger method in goroutine
+//
+// Methods of a *log.Logger write without context as well.
+func badLoggerMethodInGoroutine(ctx context.Context, logger *log.Logger) {
+	go func() {
+		_ = ctx
+		logger.Printf("done") // want `use context-aware logger instead of log.Logger.Printf in goroutine`
+	}()
+}
+
+// [BAD]: fmt.Printf in goroutine
+//
+// Printing to stdout is treated like logging.
+func badFmtPrintfInGoroutine(ctx context.Context, id int) {
+	go func() {
+		_ = ctx
+		fmt.Printf("done: %d\n", id) // want `use context-aware logger instead of fmt.Printf in goroutine`
+	}()
+}
+
+// [BAD]: log.Print in goroutine not using context
+//
+// The goroutine only needs a context in scope where it is spawned.
+func badLogPrintInGoroutineWithoutCtxUse(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		log.Print("done") // want `use context-aware logger instead of log.Print in goroutine`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Context-aware logger in goroutine
+//
+// slog's Context variants carry the context.
+func goodSlogInfoContextInGoroutine(ctx context.Context) {
+	go func() {
+		slog.InfoContext(ctx, "done")
+	}()
+}
+
+// [GOOD]: log.Printf outside goroutine
+//
+// Only calls inside go statement closures are reported.
+func goodLogPrintfOutsideGoroutine(ctx context.Context, id int) {
+	_ = ctx
+	log.Printf("done: %d", id)
+}
+
+// [GOOD]: log.Printf in goroutine without context in scope
+//
+// Without a context in scope there is nothing to correlate with.
+func goodLogPrintfWithoutCtx(id int) {
+	go func() {
+		log.Printf("done: %d", id)
+	}()
+}
+
+// [GOOD]: fmt.Sprintf in goroutine
+//
+// Formatting without writing is not reported.
+func goodFmtSprintfInGoroutine(ctx context.Context, id int) {
+	go func() {
+		slog.InfoContext(ctx, fmt.Sprintf("done: %d", id))
+	}()
+}
+
+// [GOOD]: log.Fatal in goroutine
+//
+// Fatal and Panic functions terminate instead of logging a correlated event.
+func goodLogFatalInGoroutine(ctx context.Context, err error) {
+	go func() {
+		_ = ctx
+		log.Fatal(err)
+	}()
+}
@@ -0,0 +1,19 @@
+// Package stdlogpreferred tests the stdlog checker with -preferred-logger=log/slog.InfoContext.
+package stdlogpreferred
+
+import (
+	"context"
+	"log"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Preferred logger named in diagnostic
+//
+// The diagnostic suggests the logger configured with -preferred-logger.
+func badPreferredLoggerNamed(ctx context.Context, id int) {
+	go func() {
+		_ = ctx
+		log.Printf("done: %d", id) // want `use context-aware logger slog.InfoContext instead of log.Printf in goroutine`
+	}()
+}
@@ -0,0 +1,152 @@
+// Package strict contains test fixtures for the //goroutinectx:strict directive.
+package strict
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+//vt:helper
+func process(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Goroutine only capturing ctx in a strict function
+//
+// Referencing ctx without passing it anywhere is not enough.
+//
+//goroutinectx:strict
+func badStrictGoroutineCapture(ctx context.Context) {
+	go func() { // want `goroutine in strict function should pass context "ctx" to a call`
+		_ = ctx
+		fmt.Println("work")
+	}()
+}
+
+// [BAD]: Errgroup closure only capturing ctx in a strict function
+//
+// The closure references ctx but never hands it to a call.
+//
+//goroutinectx:strict
+func badStrictErrgroupCapture(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure in strict function should pass context "ctx" to a call`
+		_ = ctx
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: WaitGroup closure only capturing ctx in a strict function
+//
+// The strict level applies to every closure checker.
+//
+//goroutinectx:strict
+func badStrictWaitGroupCapture(ctx context.Context) {
+	var wg sync.WaitGroup
+	wg.Go(func() { // want `sync.WaitGroup.Go\(\) closure in strict function should pass context "ctx" to a call`
+		_ = ctx
+	})
+	wg.Wait()
+}
+
+// [BAD]: Nested closure in a strict function
+//
+// The directive covers every closure nested in the function.
+//
+//goroutinectx:strict
+func badStrictNestedClosure(ctx context.Context) {
+	run := func() {
+		go func() { // want `goroutine in strict function should pass context "ctx" to a call`
+			_ = ctx
+		}()
+	}
+	run()
+}
+
+// [BAD]: Goroutine not using ctx at all in a strict function
+//
+// The regular diagnostic is reported unchanged.
+//
+//goroutinectx:strict
+func badStrictGoroutineNoCtx(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+		fmt.Println("work")
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Goroutine passing ctx to a call in a strict function
+//
+// ctx flows into process.
+//
+//goroutinectx:strict
+func goodStrictGoroutinePassesCtx(ctx context.Context) {
+	go func() {
+		_ = process(ctx)
+	}()
+}
+
+// [GOOD]: Goroutine calling a ctx method in a strict function
+//
+// ctx.Done() is a call on ctx.
+//
+//goroutinectx:strict
+func goodStrictGoroutineCtxMethod(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+	}()
+}
+
+// [GOOD]: Errgroup closure passing ctx to a call in a strict function
+//
+// ctx flows into process.
+//
+//goroutinectx:strict
+func goodStrictErrgroupPassesCtx(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		return process(ctx)
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Errgroup closure wrapping ctx.Err() in a strict function
+//
+// ctx.Err() is a call on ctx.
+//
+//goroutinectx:strict
+func goodStrictErrgroupWrappedCtxErr(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		return fmt.Errorf("canceled: %w", ctx.Err())
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Goroutine only capturing ctx outside a strict function
+//
+// Without the directive, capturing ctx is enough.
+func goodLenientGoroutineCapture(ctx context.Context) {
+	go func() {
+		_ = ctx
+	}()
+}
+
+// [GOOD]: Errgroup closure only capturing ctx outside a strict function
+//
+// Without the directive, capturing ctx is enough.
+func goodLenientErrgroupCapture(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		_ = ctx
+		return nil
+	})
+	_ = g.Wait()
+}
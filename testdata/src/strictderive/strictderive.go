@@ -0,0 +1,68 @@
+// Package strictderive contains test fixtures for the //goroutinectx:strict
+// directive with -goroutine-deriver.
+package strictderive
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/my-example-app/telemetry/apm"
+)
+
+//vt:helper
+func process(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Errgroup closure without deriver in a strict function
+//
+// Passing ctx is not enough when a deriver is configured.
+//
+//goroutinectx:strict
+func badStrictErrgroupNoDeriver(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure in strict function should call goroutine deriver`
+		return process(ctx)
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Goroutine without deriver in a strict function
+//
+// The goroutinederive checker already requires the deriver everywhere.
+//
+//goroutinectx:strict
+func badStrictGoroutineNoDeriver(ctx context.Context) {
+	go func() { // want "goroutine should call github.com/my-example-app/telemetry/apm.NewGoroutineContext to derive context"
+		_ = process(ctx)
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Errgroup closure calling the deriver in a strict function
+//
+// The deriver receives ctx, which also satisfies the flow requirement.
+//
+//goroutinectx:strict
+func goodStrictErrgroupDeriver(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		return process(apm.NewGoroutineContext(ctx))
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Errgroup closure without deriver outside a strict function
+//
+// Without the directive, using ctx is enough.
+func goodLenientErrgroupNoDeriver(ctx context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		return process(ctx)
+	})
+	_ = g.Wait()
+}
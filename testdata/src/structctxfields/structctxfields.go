@@ -0,0 +1,223 @@
+// Package structctxfields tests -track-struct-ctx-fields.
+package structctxfields
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+type svc struct {
+	ctx context.Context
+	g   *errgroup.Group
+}
+
+//vt:helper
+func (s *svc) doWork() error {
+	return s.ctx.Err()
+}
+
+//vt:helper
+func (s *svc) doLocal() error {
+	return nil
+}
+
+// request carries its context across a channel.
+type request struct {
+	ctx  context.Context
+	data []byte
+}
+
+//vt:helper
+func handle(ctx context.Context, data []byte) {}
+
+//goroutinectx:spawner //vt:helper
+func runTask(fn func()) {
+	go fn()
+}
+
+type worker struct {
+	g *errgroup.Group
+}
+
+//vt:helper
+func (w *worker) run() error {
+	return nil
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Errgroup closure calling a ctx-less receiver method
+//
+// The receiver carries ctx, but the called method never reads it.
+func (s *svc) badSpawnCtxlessMethod() {
+	s.g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "s.ctx"`
+		return s.doLocal()
+	})
+}
+
+// [BAD]: Errgroup closure on a receiver without ctx field
+//
+// The receiver has no ctx field, so calling its methods doesn't propagate ctx.
+func (w *worker) badSpawnCtxlessReceiver(ctx context.Context) {
+	w.g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use context "ctx"`
+		return w.run()
+	})
+}
+
+// [BAD]: Goroutine ignoring ctx field of received struct
+//
+// The goroutine receives a struct carrying ctx but only uses its data.
+func badChannelStructCtxIgnored(ctx context.Context, data []byte) {
+	ch := make(chan request, 1)
+	go func() { // want `goroutine does not propagate context "ctx"`
+		r := <-ch
+		_ = r.data
+	}()
+	ch <- request{ctx: ctx, data: data}
+}
+
+// [BAD]: Spawner func ignoring ctx field of received struct
+//
+// The spawned func receives a struct carrying ctx but only uses its data.
+func badSpawnerChannelStructCtxIgnored(ctx context.Context, data []byte) {
+	ch := make(chan *request, 1)
+	runTask(func() { // want `runTask\(\) func argument should use context "ctx"`
+		r := <-ch
+		_ = r.data
+	})
+	ch <- &request{ctx: ctx, data: data}
+}
+
+// [BAD]: Spawner func calling a ctx-less receiver method
+//
+// //goroutinectx:spawner funcs follow the same rule as errgroup closures.
+func (s *svc) badSpawnerCtxlessMethod() {
+	runTask(func() { // want `runTask\(\) func argument should use context "s.ctx"`
+		_ = s.doLocal()
+	})
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Errgroup closure calling a method reading the ctx field
+//
+// doWork reads s.ctx, so capturing s propagates the context.
+func (s *svc) goodSpawnCtxMethod() {
+	s.g.Go(func() error {
+		return s.doWork()
+	})
+}
+
+// [GOOD]: Errgroup closure reading the ctx field
+//
+// Reading s.ctx directly counts as using the context.
+func (s *svc) goodSpawnCtxField() {
+	s.g.Go(func() error {
+		return s.ctx.Err()
+	})
+}
+
+// [GOOD]: Goroutine calling a method reading the ctx field
+//
+// go statements follow the same rule.
+func (s *svc) goodGoroutineCtxMethod() {
+	go func() {
+		_ = s.doWork()
+	}()
+}
+
+// [GOOD]: Goroutine using ctx field of received struct
+//
+// The goroutine receives its context wrapped in a struct from a captured channel.
+func goodChannelStructCtx(ctx context.Context, data []byte) {
+	ch := make(chan request, 1)
+	go func() {
+		r := <-ch
+		handle(r.ctx, r.data)
+	}()
+	ch <- request{ctx: ctx, data: data}
+}
+
+// [GOOD]: Goroutine using ctx field of struct received in select
+//
+// Receiving in a select case works the same way.
+func goodChannelStructCtxInSelect(ctx context.Context, data []byte) {
+	ch := make(chan request, 1)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case r := <-ch:
+			handle(r.ctx, r.data)
+		case <-done:
+		}
+	}()
+	ch <- request{ctx: ctx, data: data}
+}
+
+// [GOOD]: Errgroup closure using ctx field of ranged struct
+//
+// Ranging over the captured channel receives from it too.
+func goodChannelStructCtxRange(ctx context.Context, data []byte) {
+	ch := make(chan request, 1)
+	g := new(errgroup.Group)
+	g.Go(func() error {
+		for r := range ch {
+			handle(r.ctx, r.data)
+		}
+		return nil
+	})
+	ch <- request{ctx: ctx, data: data}
+	close(ch)
+	_ = g.Wait()
+}
+
+// [GOOD]: Spawner func using ctx field of received struct pointer
+//
+// Struct pointers received from the captured channel are followed too.
+func goodSpawnerChannelStructCtx(ctx context.Context, data []byte) {
+	ch := make(chan *request, 1)
+	runTask(func() {
+		r := <-ch
+		handle(r.ctx, r.data)
+	})
+	ch <- &request{ctx: ctx, data: data}
+}
+
+// [GOOD]: Spawner func using ctx field of struct received in select
+//
+// Receiving in a select case works the same way.
+func goodSpawnerChannelStructCtxInSelect(ctx context.Context, data []byte) {
+	ch := make(chan request, 1)
+	done := make(chan struct{})
+	runTask(func() {
+		select {
+		case <-done:
+		case r := <-ch:
+			handle(r.ctx, r.data)
+		}
+	})
+	ch <- request{ctx: ctx, data: data}
+}
+
+// [GOOD]: Spawner func using ctx field of ranged struct
+//
+// Ranging over the captured channel receives from it too.
+func goodSpawnerChannelStructCtxRange(ctx context.Context, data []byte) {
+	ch := make(chan request, 1)
+	runTask(func() {
+		for r := range ch {
+			handle(r.ctx, r.data)
+		}
+	})
+	ch <- request{ctx: ctx, data: data}
+}
+
+// [GOOD]: Spawner func calling a method reading the ctx field
+//
+// //goroutinectx:spawner funcs follow the same rule as errgroup closures.
+func (s *svc) goodSpawnerCtxMethod() {
+	runTask(func() {
+		_ = s.doWork()
+	})
+}
@@ -0,0 +1,93 @@
+// Package synconce tests the synconce checker.
+package synconce
+
+import (
+	"context"
+	"sync"
+)
+
+//vt:helper
+func startServer() {}
+
+//vt:helper
+func startServerContext(ctx context.Context) {}
+
+//vt:helper
+func loadDefaults() {}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Do callback without ctx
+//
+// The lazy initializer ignores the context in scope.
+func badOnceDoNoCapture(ctx context.Context) {
+	var once sync.Once
+	once.Do(func() { // want `sync.Once.Do\(\) closure should use context "ctx"`
+		startServer()
+	})
+}
+
+// [BAD]: Do callback inside a goroutine
+//
+// Neither the goroutine nor the Do callback uses ctx.
+func badOnceDoInGoroutine(ctx context.Context) {
+	var once sync.Once
+	go func() { // want `goroutine does not propagate context "ctx"`
+		once.Do(func() { // want `sync.Once.Do\(\) closure should use context "ctx"`
+			startServer()
+		})
+	}()
+}
+
+// [BAD]: Do with a function reference without ctx
+//
+// Named functions are checked like func literals.
+func badOnceDoFuncRef(ctx context.Context) {
+	var once sync.Once
+	once.Do(loadDefaults) // want `sync.Once.Do\(\) closure should use context "ctx"`
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Do callback capturing ctx
+//
+// The lazy initializer observes the caller's context.
+func goodOnceDoCapture(ctx context.Context) {
+	var once sync.Once
+	once.Do(func() {
+		startServerContext(ctx)
+	})
+}
+
+// [GOOD]: Do callback inside a goroutine capturing ctx
+//
+// Both the goroutine and the Do callback use ctx.
+func goodOnceDoInGoroutine(ctx context.Context) {
+	var once sync.Once
+	go func() {
+		once.Do(func() {
+			startServerContext(ctx)
+		})
+	}()
+}
+
+// [GOOD]: Do without ctx in scope
+//
+// There is no context to propagate.
+func goodOnceDoNoCtx() {
+	var once sync.Once
+	once.Do(func() {
+		startServer()
+	})
+}
+
+// [GOOD]: Do callback with ignore directive
+//
+// Initialization deliberately detached from any request.
+func goodOnceDoIgnored(ctx context.Context) {
+	var once sync.Once
+	//goroutinectx:ignore synconce
+	once.Do(func() {
+		startServer()
+	})
+}
@@ -0,0 +1,76 @@
+// Package timetick tests the timetick checker.
+package timetick
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: time.Tick with ctx in scope
+//
+// The ticker can never be stopped and outlives the context.
+func badTimeTick(ctx context.Context) {
+	for range time.Tick(time.Second) { // want `avoid time.Tick in context-aware code; use time.NewTicker with ctx.Done\(\)`
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// [BAD]: time.Tick inside goroutine
+//
+// The goroutine returns on ctx.Done(), but the ticker keeps running.
+func badTimeTickInGoroutine(ctx context.Context) {
+	go func() {
+		tick := time.Tick(time.Second) // want `avoid time.Tick in context-aware code; use time.NewTicker with ctx.Done\(\)`
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tick:
+				fmt.Println("tick")
+			}
+		}
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: time.NewTicker with Stop and ctx.Done()
+//
+// The ticker is stopped when the context is canceled.
+func goodNewTicker(ctx context.Context) {
+	go func() {
+		t := time.NewTicker(time.Second)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				fmt.Println("tick")
+			}
+		}
+	}()
+}
+
+// [GOOD]: time.Tick without ctx in scope
+//
+// Long-lived tickers outside context-aware code are not reported.
+func goodTimeTickWithoutCtx() {
+	for range time.Tick(time.Second) {
+		fmt.Println("tick")
+	}
+}
+
+// [GOOD]: time.Tick with ignore directive
+//
+// The directive suppresses the timetick checker.
+func goodTimeTickIgnored(ctx context.Context) {
+	tick := time.Tick(time.Minute) //goroutinectx:ignore timetick
+	<-tick
+	_ = ctx
+}
@@ -0,0 +1,209 @@
+// Package transitive contains test fixtures for the transitive checker.
+package transitive
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"strings"
+)
+
+//vt:helper
+func doWork() {}
+
+//vt:helper
+func doWorkCtx(ctx context.Context) { _ = ctx }
+
+// ===== SHOULD REPORT =====
+
+//vt:helper
+func spawnWorker() {
+	go doWork()
+}
+
+// [BAD]: Helper spawning a goroutine
+//
+// The helper spawns a goroutine but can't receive the context in scope.
+func badHelperSpawnsGoroutine(ctx context.Context) {
+	spawnWorker() // want `helper spawnWorker should accept context.Context to propagate from badHelperSpawnsGoroutine`
+}
+
+//vt:helper
+func logResult(id int) {
+	log.Printf("done: %d", id)
+}
+
+// [BAD]: Helper logging
+//
+// The helper logs through the stdlib logger, so its output can't carry the context.
+func badHelperLogs(ctx context.Context) {
+	logResult(1) // want `helper logResult should accept context.Context to propagate from badHelperLogs`
+}
+
+//vt:helper
+func notify(msg string) {
+	slog.Info(msg)
+}
+
+// [BAD]: Helper logging through slog
+//
+// slog calls without a context count as logging.
+func badHelperSlogs(ctx context.Context) {
+	notify("started") // want `helper notify should accept context.Context to propagate from badHelperSlogs`
+}
+
+//vt:helper
+func startPipeline() {
+	prepare()
+	spawnWorker()
+}
+
+//vt:helper
+func prepare() {}
+
+// [BAD]: Helper spawning through another helper
+//
+// The requirement propagates through helpers that only call a spawning helper.
+func badHelperSpawnsTransitively(ctx context.Context) {
+	startPipeline() // want `helper startPipeline should accept context.Context to propagate from badHelperSpawnsTransitively`
+}
+
+//vt:helper
+func runLater() {
+	func() {
+		go doWork()
+	}()
+}
+
+// [BAD]: Helper spawning in a func literal
+//
+// Goroutines spawned by func literals inside the helper count.
+func badHelperSpawnsInFuncLit(ctx context.Context) {
+	runLater() // want `helper runLater should accept context.Context to propagate from badHelperSpawnsInFuncLit`
+}
+
+type worker struct{}
+
+//vt:helper
+func (w *worker) start() {
+	go doWork()
+}
+
+// [BAD]: Method helper spawning a goroutine
+//
+// Method helpers are named with their receiver type.
+func badMethodHelper(ctx context.Context, w *worker) {
+	w.start() // want `helper worker.start should accept context.Context to propagate from badMethodHelper`
+}
+
+//vt:helper
+func ping(n int) {
+	if n > 0 {
+		pong(n - 1)
+	}
+}
+
+//vt:helper
+func pong(n int) {
+	ping(n)
+	go doWork()
+}
+
+// [BAD]: Mutually recursive helpers
+//
+// Helpers calling each other need a context if either spawns.
+func badMutuallyRecursiveHelpers(ctx context.Context) {
+	ping(3) // want `helper ping should accept context.Context to propagate from badMutuallyRecursiveHelpers`
+}
+
+// [BAD]: Helper called in a goroutine
+//
+// The caller is the enclosing function, even inside a func literal.
+func badHelperCalledInClosure(ctx context.Context) {
+	go func() {
+		_ = ctx
+		spawnWorker() // want `helper spawnWorker should accept context.Context to propagate from badHelperCalledInClosure`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+//vt:helper
+func spawnWorkerCtx(ctx context.Context) {
+	go doWorkCtx(ctx)
+}
+
+// [GOOD]: Helper accepting the context
+//
+// A helper with a context parameter is checked on its own.
+func goodHelperAcceptsContext(ctx context.Context) {
+	spawnWorkerCtx(ctx)
+}
+
+//vt:helper
+func compute(n int) int {
+	return n * 2
+}
+
+// [GOOD]: Helper neither spawning nor logging
+//
+// Helpers without goroutines or logging don't need the context.
+func goodPureHelper(ctx context.Context) {
+	_ = compute(1)
+}
+
+// [GOOD]: No context in scope
+//
+// Without a context there is nothing to propagate.
+func goodNoContextInScope() {
+	spawnWorker()
+}
+
+// [GOOD]: Function from another package
+//
+// Only helpers declared in the analyzed package are followed.
+func goodOtherPackage(ctx context.Context) {
+	_ = strings.ToUpper("x")
+}
+
+//vt:helper
+func even(n int) bool {
+	if n == 0 {
+		return true
+	}
+	return odd(n - 1)
+}
+
+//vt:helper
+func odd(n int) bool {
+	if n == 0 {
+		return false
+	}
+	return even(n - 1)
+}
+
+// [GOOD]: Mutually recursive pure helpers
+//
+// Recursion without goroutines or logging terminates and is not reported.
+func goodMutuallyRecursivePureHelpers(ctx context.Context) {
+	_ = even(4)
+}
+
+// [GOOD]: Ignore directive
+//
+// The diagnostic is suppressed by an ignore directive.
+func goodIgnored(ctx context.Context) {
+	spawnWorker() //goroutinectx:ignore transitive
+}
+
+//vt:helper
+func spawnWithBackground() {
+	spawnWorkerCtx(context.Background())
+}
+
+// [LIMITATION]: Helper passing a fresh context to a context-aware helper
+//
+// Helpers accepting a context end the search, even when called with context.Background().
+func limitationFreshContextHelper(ctx context.Context) {
+	spawnWithBackground()
+}
@@ -0,0 +1,148 @@
+// Package unguardedchanop tests the unguardedchanop checker.
+package unguardedchanop
+
+import (
+	"context"
+	"time"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Channel send in goroutine
+//
+// The send blocks forever once nobody receives, leaking the goroutine.
+func badGoroutineChannelSend(ctx context.Context) {
+	ch := make(chan int)
+	go func() {
+		_ = ctx
+		ch <- 42 // want `blocking channel op in goroutine should be in a select with ctx.Done\(\)`
+	}()
+	<-ch
+}
+
+// [BAD]: Channel receive in goroutine
+//
+// The receive blocks forever once nobody sends.
+func badGoroutineChannelRecv(ctx context.Context, ch chan int) {
+	go func() {
+		_ = ctx
+		v := <-ch // want `blocking channel op in goroutine should be in a select with ctx.Done\(\)`
+		_ = v
+	}()
+}
+
+// [BAD]: Select without ctx.Done
+//
+// A select over channels only still blocks without observing cancellation.
+func badGoroutineSelectWithoutDone(ctx context.Context, a, b chan int) {
+	go func() {
+		_ = ctx
+		select {
+		case a <- 1: // want `blocking channel op in goroutine should be in a select with ctx.Done\(\)`
+		case <-b: // want `blocking channel op in goroutine should be in a select with ctx.Done\(\)`
+		}
+	}()
+}
+
+// [BAD]: Channel op in select case body
+//
+// Only the communication of a guarded case is covered, not its body.
+func badGoroutineSendInCaseBody(ctx context.Context, in, out chan int) {
+	go func() {
+		select {
+		case v := <-in:
+			out <- v // want `blocking channel op in goroutine should be in a select with ctx.Done\(\)`
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Channel send in select with ctx.Done
+//
+// The send is abandoned when the context is cancelled.
+func goodGoroutineSendWithDone(ctx context.Context) {
+	ch := make(chan int)
+	go func() {
+		select {
+		case ch <- 42:
+		case <-ctx.Done():
+			return
+		}
+	}()
+	<-ch
+}
+
+// [GOOD]: Channel receive in select with ctx.Done
+//
+// The receive is abandoned when the context is cancelled.
+func goodGoroutineRecvWithDone(ctx context.Context, ch chan int) {
+	go func() {
+		select {
+		case v := <-ch:
+			_ = v
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// [GOOD]: Select with default
+//
+// A select with a default case never blocks.
+func goodGoroutineSelectWithDefault(ctx context.Context, ch chan int) {
+	go func() {
+		_ = ctx
+		select {
+		case ch <- 1:
+		default:
+		}
+	}()
+}
+
+// [GOOD]: Waiting on ctx.Done directly
+//
+// Receiving from ctx.Done() is the cancellation itself.
+func goodGoroutineWaitsOnDone(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+	}()
+}
+
+// [GOOD]: Channel op outside goroutine
+//
+// Only go statement closures are checked.
+func goodChannelOpOutsideGoroutine(ctx context.Context, ch chan int) {
+	_ = ctx
+	ch <- 1
+}
+
+// [GOOD]: Channel op in goroutine without context in scope
+//
+// Without a context there is no Done channel to select on.
+func goodGoroutineWithoutCtx(ch chan int) {
+	go func() {
+		ch <- 1
+	}()
+}
+
+// [LIMITATION]: Buffered channel send
+//
+// Buffering is not tracked, so a send that cannot block is still reported.
+func limitationGoroutineBufferedSend(ctx context.Context) {
+	ch := make(chan int, 1)
+	go func() {
+		_ = ctx
+		ch <- 1 // want `blocking channel op in goroutine should be in a select with ctx.Done\(\)`
+	}()
+}
+
+// [LIMITATION]: Timer receive
+//
+// Receives from time.After are bounded but still reported.
+func limitationGoroutineTimerRecv(ctx context.Context) {
+	go func() {
+		_ = ctx
+		<-time.After(time.Second) // want `blocking channel op in goroutine should be in a select with ctx.Done\(\)`
+	}()
+}
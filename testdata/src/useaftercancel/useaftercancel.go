@@ -0,0 +1,136 @@
+// Package useaftercancel tests the useaftercancel checker.
+package useaftercancel
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Use after cancel
+//
+// The derived context is already canceled when passed on.
+func badUseAfterCancel(ctx context.Context) {
+	ctx2, cancel := context.WithCancel(ctx)
+	cancel()
+	doWork(ctx2) // want `context used after cancel\(\)`
+}
+
+// [BAD]: Use after cancel with timeout
+//
+// context.WithTimeout returns a cancel function too.
+func badUseAfterCancelTimeout(ctx context.Context) {
+	ctx2, cancel := context.WithTimeout(ctx, time.Second)
+	doWork(ctx2)
+	cancel()
+	doWork(ctx2) // want `context used after cancel\(\)`
+}
+
+// [BAD]: Use after cancel with deadline
+//
+// context.WithDeadline returns a cancel function too.
+func badUseAfterCancelDeadline(ctx context.Context) {
+	ctx2, cancel := context.WithDeadline(ctx, time.Now().Add(time.Second))
+	cancel()
+	doWork(ctx2) // want `context used after cancel\(\)`
+}
+
+// [BAD]: Use after cancel cause
+//
+// context.WithCancelCause returns a CancelCauseFunc.
+func badUseAfterCancelCause(ctx context.Context) {
+	ctx2, cancel := context.WithCancelCause(ctx)
+	cancel(errors.New("done"))
+	doWork(ctx2) // want `context used after cancel\(\)`
+}
+
+// [BAD]: Use after cancel in later block
+//
+// The cancel call dominates the block containing the use.
+func badUseAfterCancelLaterBlock(ctx context.Context, retry bool) {
+	ctx2, cancel := context.WithCancel(ctx)
+	cancel()
+	if retry {
+		doWork(ctx2) // want `context used after cancel\(\)`
+	}
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Use before cancel
+//
+// The context is used before it is canceled.
+func goodUseBeforeCancel(ctx context.Context) {
+	ctx2, cancel := context.WithCancel(ctx)
+	doWork(ctx2)
+	cancel()
+}
+
+// [GOOD]: Deferred cancel
+//
+// A deferred cancel runs after every use.
+func goodDeferredCancel(ctx context.Context) {
+	ctx2, cancel := context.WithCancel(ctx)
+	defer cancel()
+	doWork(ctx2)
+}
+
+// [GOOD]: Conditional cancel
+//
+// The cancel call does not run on every path to the use.
+func goodConditionalCancel(ctx context.Context, abort bool) {
+	ctx2, cancel := context.WithCancel(ctx)
+	if abort {
+		cancel()
+	}
+	doWork(ctx2)
+	cancel()
+}
+
+// [GOOD]: Method call after cancel
+//
+// Inspecting the canceled context itself is expected.
+func goodErrAfterCancel(ctx context.Context) error {
+	ctx2, cancel := context.WithCancel(ctx)
+	doWork(ctx2)
+	cancel()
+	<-ctx2.Done()
+	return ctx2.Err()
+}
+
+// [GOOD]: Parent used after cancel
+//
+// Only the derived context is canceled, not the parent.
+func goodParentAfterCancel(ctx context.Context) {
+	ctx2, cancel := context.WithCancel(ctx)
+	doWork(ctx2)
+	cancel()
+	doWork(ctx)
+}
+
+// [GOOD]: Fresh context per iteration
+//
+// Each iteration derives a new context before canceling it.
+func goodLoopPerIteration(ctx context.Context, n int) {
+	for range n {
+		ctx2, cancel := context.WithTimeout(ctx, time.Second)
+		doWork(ctx2)
+		cancel()
+	}
+}
+
+// [GOOD]: Use after cancel with ignore directive
+//
+// The ignore directive suppresses the report.
+func goodUseAfterCancelIgnored(ctx context.Context) {
+	ctx2, cancel := context.WithCancel(ctx)
+	cancel()
+	doWork(ctx2) //goroutinectx:ignore useaftercancel
+}
+
+//vt:helper
+func doWork(ctx context.Context) {
+	_ = ctx
+}
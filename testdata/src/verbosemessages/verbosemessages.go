@@ -0,0 +1,62 @@
+// Package verbosemessages tests diagnostics naming every context in scope
+// (-verbose-messages).
+package verbosemessages
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+//goroutinectx:spawner //vt:helper
+func runTask(fn func()) {
+	go fn()
+}
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Goroutine with two contexts
+//
+// Both context parameters are named.
+func badGoroutineTwoContexts(ctx1, ctx2 context.Context) {
+	go func() { // want `goroutine does not propagate any of contexts \["ctx1" "ctx2"\]`
+	}()
+}
+
+// [BAD]: Errgroup with two contexts
+//
+// Callback checkers name every context too.
+func badErrgroupTwoContexts(ctx1 context.Context, ctx2 context.Context) {
+	g := new(errgroup.Group)
+	g.Go(func() error { // want `errgroup.Group.Go\(\) closure should use one of contexts \["ctx1" "ctx2"\]`
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [BAD]: Spawner with three contexts
+//
+// Func arguments of spawners name every context in scope.
+func badSpawnerThreeContexts(a, b, c context.Context) {
+	runTask(func() { // want `runTask\(\) func argument should use one of contexts \["a" "b" "c"\]`
+	})
+}
+
+// [BAD]: Goroutine with single context
+//
+// A single context keeps the default wording.
+func badGoroutineSingleContext(ctx context.Context) {
+	go func() { // want `goroutine does not propagate context "ctx"`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Second context used
+//
+// Using any of the contexts satisfies the check.
+func goodSecondContextUsed(ctx1, ctx2 context.Context) {
+	go func() {
+		_ = ctx2
+	}()
+}
@@ -0,0 +1,93 @@
+// Package waiterror tests the waiterror checker.
+package waiterror
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: Wait error discarded
+//
+// Assigning the error to _ loses the cancellation signal.
+func badWaitDiscarded(ctx context.Context) {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return ctx.Err()
+	})
+	_ = g.Wait() // want `errgroup.Wait\(\) error is discarded`
+}
+
+// [BAD]: Wait error discarded on zero group
+//
+// A plain errgroup.Group is reported the same way.
+func badWaitDiscardedZeroGroup(ctx context.Context) {
+	var g errgroup.Group
+	g.Go(func() error {
+		return ctx.Err()
+	})
+	_ = g.Wait() // want `errgroup.Wait\(\) error is discarded`
+}
+
+// [BAD]: Wait error discarded in goroutine
+//
+// The goroutine closure inherits the enclosing function's context scope.
+func badWaitDiscardedInGoroutine(ctx context.Context) {
+	var g errgroup.Group
+	go func() {
+		_ = ctx
+		_ = g.Wait() // want `errgroup.Wait\(\) error is discarded`
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: Wait error returned
+//
+// The error is propagated to the caller.
+func goodWaitReturned(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return ctx.Err()
+	})
+	return g.Wait()
+}
+
+// [GOOD]: Wait error handled
+//
+// The error is inspected before continuing.
+func goodWaitHandled(ctx context.Context) {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return ctx.Err()
+	})
+	if err := g.Wait(); err != nil {
+		fmt.Println(err)
+	}
+}
+
+// [GOOD]: No context in scope
+//
+// Without a context there is no cancellation signal to lose.
+func goodWaitDiscardedNoCtx() {
+	var g errgroup.Group
+	g.Go(func() error {
+		return nil
+	})
+	_ = g.Wait()
+}
+
+// [GOOD]: Wait error discarded with ignore
+//
+// The ignore directive suppresses the report.
+func goodWaitDiscardedIgnored(ctx context.Context) {
+	var g errgroup.Group
+	g.Go(func() error {
+		return ctx.Err()
+	})
+	//goroutinectx:ignore waiterror
+	_ = g.Wait()
+}
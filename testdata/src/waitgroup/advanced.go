@@ -226,3 +226,45 @@ func goodDeferNestedClosure(ctx context.Context) {
 	})
 	wg.Wait()
 }
+
+// ===== CONDITIONALLY ASSIGNED FUNC VARIABLE =====
+
+// [BAD]: Func variable assigned on both branches, one without ctx
+//
+// The spawned value may be the closure that drops ctx.
+//
+// See also:
+//   errgroup: badPhiClosureOneBranchNoCtx
+func badPhiClosureOneBranchNoCtx(ctx context.Context, fast bool) {
+	var wg sync.WaitGroup
+	withCtx := func() { _ = ctx }
+	withoutCtx := func() {}
+	var fn func()
+	if fast {
+		fn = withCtx
+	} else {
+		fn = withoutCtx
+	}
+	wg.Go(fn) // want `sync.WaitGroup.Go\(\) closure should use context "ctx"`
+	wg.Wait()
+}
+
+// [GOOD]: Func variable assigned on both branches, all with ctx
+//
+// Every value reaching the call captures ctx.
+//
+// See also:
+//   errgroup: goodPhiClosureBothBranchesCtx
+func goodPhiClosureBothBranchesCtx(ctx context.Context, fast bool) {
+	var wg sync.WaitGroup
+	quick := func() { _ = ctx }
+	slow := func() { <-ctx.Done() }
+	var fn func()
+	if fast {
+		fn = quick
+	} else {
+		fn = slow
+	}
+	wg.Go(fn)
+	wg.Wait()
+}
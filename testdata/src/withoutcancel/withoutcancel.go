@@ -0,0 +1,75 @@
+// Package withoutcancel tests the withoutcancel checker.
+package withoutcancel
+
+import (
+	"context"
+	"fmt"
+)
+
+// ===== SHOULD REPORT =====
+
+// [BAD]: WithoutCancel in request goroutine
+//
+// The goroutine keeps running after the request is canceled.
+func badWithoutCancelInGoroutine(ctx context.Context) {
+	go func() {
+		ctx := context.WithoutCancel(ctx) // want `context.WithoutCancel in a request goroutine detaches cancellation`
+		fmt.Println(ctx)
+	}()
+}
+
+// [BAD]: WithoutCancel in nested closure within goroutine
+//
+// The call is still part of the goroutine's body.
+func badWithoutCancelNestedClosure(ctx context.Context) {
+	go func() {
+		run := func() {
+			process(context.WithoutCancel(ctx)) // want `context.WithoutCancel in a request goroutine detaches cancellation`
+		}
+		run()
+	}()
+}
+
+// ===== SHOULD NOT REPORT =====
+
+// [GOOD]: WithoutCancel outside goroutine
+//
+// Detaching in the function body itself is not reported.
+func goodWithoutCancelOutsideGoroutine(ctx context.Context) {
+	detached := context.WithoutCancel(ctx)
+	go func() {
+		process(detached)
+	}()
+}
+
+// [GOOD]: Goroutine uses ctx directly
+//
+// The goroutine observes request cancellation.
+func goodGoroutineUsesCtx(ctx context.Context) {
+	go func() {
+		process(ctx)
+	}()
+}
+
+// [GOOD]: WithoutCancel passed as go statement argument
+//
+// The call is evaluated by the spawning function, not inside the goroutine closure.
+func goodWithoutCancelAsGoArg(ctx context.Context) {
+	go process(context.WithoutCancel(ctx))
+}
+
+// [GOOD]: Intentional WithoutCancel with ignore directive
+//
+// Fire-and-forget work that must outlive the request is explicitly acknowledged.
+func goodWithoutCancelIgnored(ctx context.Context) {
+	go func() {
+		//goroutinectx:ignore withoutcancel - audit log must be written after the response
+		ctx := context.WithoutCancel(ctx)
+		process(ctx)
+	}()
+}
+
+//vt:helper
+func process(ctx context.Context) {
+	_ = ctx
+}